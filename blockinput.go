@@ -0,0 +1,97 @@
+package winapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"time"
+)
+
+var (
+	// ErrNotElevated is returned when input cannot be blocked because the
+	// calling process does not run at a high integrity level.
+	ErrNotElevated = errors.New("winapi: process is not elevated")
+
+	// ErrNotInputDesktop is returned when input cannot be blocked because the
+	// desktop of the calling thread is not the one receiving input, such as
+	// while the lock screen or a UAC prompt is shown.
+	ErrNotInputDesktop = errors.New("winapi: thread is not on the input desktop")
+)
+
+// A BlockInputDiagnosis is what is known of the calling thread and process
+// when BlockInput fails. What could not be determined is left unset.
+type BlockInputDiagnosis struct {
+	// NotInputDesktop is set if the desktop of the calling thread is not the
+	// one receiving input.
+	NotInputDesktop bool
+
+	// NotElevated is set if the process runs below a high integrity level.
+	NotElevated bool
+}
+
+// Err returns err, the error of BlockInput, wrapped in [ErrNotInputDesktop] or
+// [ErrNotElevated] as d tells why it failed, or err itself if d does not tell.
+func (d BlockInputDiagnosis) Err(err error) error {
+	switch {
+	case d.NotInputDesktop:
+		return fmt.Errorf("%w: %w", ErrNotInputDesktop, err)
+	case d.NotElevated:
+		return fmt.Errorf("%w: %w", ErrNotElevated, err)
+	}
+
+	return err
+}
+
+// An InputBlocker blocks input if block is set and unblocks it otherwise, as
+// BlockInput does.
+type InputBlocker func(block bool) error
+
+// Run blocks input with b, calls fn and unblocks input when fn returns or
+// panics, when ctx is done, or once maxDuration has passed, whichever comes
+// first. A maxDuration of 0 or less sets no limit. fn is passed a context
+// that is done once input is unblocked.
+//
+// Input is blocked and unblocked by a watchdog goroutine locked to its own
+// thread, since only the blocking thread can unblock input, so input is
+// unblocked on time even if fn hangs.
+// It returns the error of fn joined with the error of unblocking input, or
+// the error of blocking input, in which case fn is not called.
+//
+// Experimental: Run has not been tested or used internally.
+func (b InputBlocker) Run(ctx context.Context, maxDuration time.Duration, fn func(context.Context) error) (err error) {
+	var cancel context.CancelFunc
+	if maxDuration > 0 {
+		ctx, cancel = context.WithTimeout(ctx, maxDuration)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	blocked := make(chan error, 1)
+	unblocked := make(chan error, 1)
+	go func() {
+		runtime.LockOSThread()
+		defer runtime.UnlockOSThread()
+
+		if err := b(true); err != nil {
+			blocked <- err
+			return
+		}
+		blocked <- nil
+
+		<-ctx.Done()
+		unblocked <- b(false)
+	}()
+
+	if err := <-blocked; err != nil {
+		return err
+	}
+
+	defer func() {
+		cancel()
+		err = errors.Join(err, <-unblocked)
+	}()
+
+	return fn(ctx)
+}
@@ -0,0 +1,145 @@
+package winapi_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kamaranl/gotools/test"
+	"github.com/kamaranl/winapi"
+)
+
+func TestBlockInputDiagnosis(t *testing.T) {
+	tName := "BlockInputDiagnosis"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	denied := errors.New("access denied")
+
+	scenes := []test.Scene{
+		{Input: winapi.BlockInputDiagnosis{}, Output: []error{denied}},
+		{Input: winapi.BlockInputDiagnosis{NotElevated: true}, Output: []error{denied, winapi.ErrNotElevated}},
+		{Input: winapi.BlockInputDiagnosis{NotInputDesktop: true}, Output: []error{denied, winapi.ErrNotInputDesktop}},
+		{Input: winapi.BlockInputDiagnosis{NotInputDesktop: true, NotElevated: true}, Output: []error{denied, winapi.ErrNotInputDesktop}},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			err := s.Input.(winapi.BlockInputDiagnosis).Err(denied)
+			want := s.Output.([]error)
+			for _, target := range []error{denied, winapi.ErrNotElevated, winapi.ErrNotInputDesktop} {
+				if got, wantIs := errors.Is(err, target), slices.Contains(want, target); got != wantIs {
+					t.Errorf(test.ErrWantFGotF, fmt.Sprintf("errors.Is(%v) = %t", target, wantIs), err)
+				}
+			}
+		})
+	}
+}
+
+func TestInputBlocker(t *testing.T) {
+	tName := "InputBlocker"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	var (
+		errBlock   = errors.New("block")
+		errUnblock = errors.New("unblock")
+		errFn      = errors.New("fn")
+	)
+
+	type run struct {
+		blockErr, unblockErr error
+		maxDuration          time.Duration
+		cancel               bool   // cancel ctx once fn is called
+		fn                   string // "ok", "err", "panic" or "hang"
+	}
+	type result struct {
+		errs     []error
+		calls    []bool
+		called   bool
+		panicked bool
+	}
+
+	scenes := []test.Scene{
+		{Input: run{fn: "ok"}, Output: result{calls: []bool{true, false}, called: true}},
+		{Input: run{fn: "err"}, Output: result{errs: []error{errFn}, calls: []bool{true, false}, called: true}},
+		{Input: run{fn: "panic"}, Output: result{calls: []bool{true, false}, called: true, panicked: true}},
+		{Input: run{fn: "hang", cancel: true}, Output: result{calls: []bool{true, false}, called: true}},
+		{Input: run{fn: "hang", maxDuration: time.Millisecond}, Output: result{calls: []bool{true, false}, called: true}},
+		{Input: run{fn: "ok", blockErr: errBlock}, Output: result{errs: []error{errBlock}, calls: []bool{true}}},
+		{Input: run{fn: "err", unblockErr: errUnblock}, Output: result{errs: []error{errFn, errUnblock}, calls: []bool{true, false}, called: true}},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			r := s.Input.(run)
+			want := s.Output.(result)
+
+			var (
+				mu        sync.Mutex
+				got       result
+				unblocked = make(chan struct{})
+			)
+			blocker := winapi.InputBlocker(func(block bool) error {
+				mu.Lock()
+				defer mu.Unlock()
+
+				got.calls = append(got.calls, block)
+				if block {
+					return r.blockErr
+				}
+				close(unblocked)
+				return r.unblockErr
+			})
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			var err error
+			func() {
+				defer func() { got.panicked = recover() != nil }()
+
+				err = blocker.Run(ctx, r.maxDuration, func(context.Context) error {
+					got.called = true
+					if r.cancel {
+						cancel()
+					}
+
+					switch r.fn {
+					case "err":
+						return errFn
+					case "panic":
+						panic(r.fn)
+					case "hang":
+						// Input must be unblocked while fn is still running.
+						select {
+						case <-unblocked:
+						case <-time.After(time.Second):
+							t.Error("input not unblocked while fn hangs")
+						}
+					}
+					return nil
+				})
+			}()
+
+			for _, target := range []error{errBlock, errUnblock, errFn} {
+				if errors.Is(err, target) != slices.Contains(want.errs, target) {
+					t.Errorf(test.ErrWantFGotF, want.errs, err)
+				}
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if !reflect.DeepEqual(got.calls, want.calls) || got.called != want.called || got.panicked != want.panicked {
+				t.Errorf(test.ErrWantFGotF, want, got)
+			}
+		})
+	}
+}
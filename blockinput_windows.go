@@ -0,0 +1,93 @@
+//go:build windows
+
+package winapi
+
+import (
+	"context"
+	"errors"
+	"time"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// WithBlockedInput blocks keyboard and mouse input with [BlockInput], calls fn
+// and unblocks input when fn returns or panics, when ctx is done, or once
+// maxDuration has passed, whichever comes first, as [InputBlocker.Run] does.
+// The system also unblocks input if the process exits.
+// It returns the error of fn joined with the error of unblocking input, or an
+// error wrapping [ErrNotElevated] or [ErrNotInputDesktop] if input cannot be
+// blocked, in which case fn is not called.
+//
+// Experimental: WithBlockedInput has not been tested or used internally.
+func WithBlockedInput(ctx context.Context, maxDuration time.Duration, fn func(context.Context) error) error {
+	return InputBlocker(blockInput).Run(ctx, maxDuration, fn)
+}
+
+// blockInput calls [BlockInput], telling why it failed to block input.
+func blockInput(block bool) error {
+	err := BlockInput(block)
+	if err != nil && block {
+		return diagnoseBlockInput().Err(err)
+	}
+
+	return err
+}
+
+// diagnoseBlockInput tells why BlockInput failed on the calling thread.
+func diagnoseBlockInput() BlockInputDiagnosis {
+	var d BlockInputDiagnosis
+	if ok, err := onInputDesktop(); err == nil && !ok {
+		d.NotInputDesktop = true
+	}
+	if il, err := ProcessIntegrityLevel(0); err == nil && il < SECURITY_MANDATORY_HIGH_RID {
+		d.NotElevated = true
+	}
+
+	return d
+}
+
+// onInputDesktop reports whether the desktop of the calling thread is the one
+// receiving input. The input desktop cannot be opened at all while the secure
+// desktop is shown.
+func onInputDesktop() (bool, error) {
+	input, err := OpenInputDesktop(0, false, DESKTOP_READOBJECTS)
+	if err != nil {
+		if errors.Is(err, windows.ERROR_ACCESS_DENIED) {
+			return false, nil
+		}
+		return false, err
+	}
+	defer CloseDesktop(input)
+
+	own, err := GetThreadDesktop(windows.GetCurrentThreadId())
+	if err != nil {
+		return false, err
+	}
+
+	inputName, err := desktopName(input)
+	if err != nil {
+		return false, err
+	}
+	ownName, err := desktopName(own)
+	if err != nil {
+		return false, err
+	}
+
+	return inputName == ownName, nil
+}
+
+// desktopName returns the name of a desktop.
+func desktopName(desktop Handle) (string, error) {
+	n, err := GetUserObjectInformationW(desktop, UOI_NAME, nil)
+	if n == 0 {
+		return "", err
+	}
+
+	buf := make([]byte, n)
+	if _, err := GetUserObjectInformationW(desktop, UOI_NAME, buf); err != nil {
+		return "", err
+	}
+
+	return windows.UTF16ToString(unsafe.Slice((*uint16)(unsafe.Pointer(&buf[0])), n/2)), nil
+}
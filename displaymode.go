@@ -0,0 +1,479 @@
+package winapi
+
+import (
+	"errors"
+	"fmt"
+	"unicode/utf16"
+	"unsafe"
+)
+
+// ErrDisplayModeReverted is returned when confirming a display mode change
+// that was already reverted.
+var ErrDisplayModeReverted = errors.New("winapi: display mode change reverted")
+
+// #region types
+
+// DM represents the members of a [DEVMODEW] that are set.
+type DM uint32
+
+// [DM] constants (partial).
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/wingdi/ns-wingdi-devmodew#members
+const (
+	DM_POSITION           DM = 0x00000020
+	DM_DISPLAYORIENTATION DM = 0x00000080
+	DM_BITSPERPEL         DM = 0x00040000
+	DM_PELSWIDTH          DM = 0x00080000
+	DM_PELSHEIGHT         DM = 0x00100000
+	DM_DISPLAYFLAGS       DM = 0x00200000
+	DM_DISPLAYFREQUENCY   DM = 0x00400000
+	DM_DISPLAYFIXEDOUTPUT DM = 0x20000000
+)
+
+// DM_SPECVERSION is the version of the [DEVMODEW] struct.
+const DM_SPECVERSION = 0x0401
+
+// DMDO represents the orientation of a display, clockwise from its natural
+// orientation.
+type DMDO uint32
+
+// [DMDO] constants.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/wingdi/ns-wingdi-devmodew#members
+const (
+	DMDO_DEFAULT DMDO = iota
+	DMDO_90
+	DMDO_180
+	DMDO_270
+)
+
+// Degrees returns the rotation of o, in degrees.
+func (o DMDO) Degrees() int {
+	return int(o%4) * 90
+}
+
+// Portrait reports whether o turns a landscape display on its side.
+func (o DMDO) Portrait() bool {
+	return o == DMDO_90 || o == DMDO_270
+}
+
+// CDS represents how ChangeDisplaySettingsExW changes the display mode.
+type CDS uint32
+
+// [CDS] constants.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-changedisplaysettingsexw#parameters
+const (
+	CDS_UPDATEREGISTRY       CDS = 0x00000001
+	CDS_TEST                 CDS = 0x00000002
+	CDS_FULLSCREEN           CDS = 0x00000004
+	CDS_GLOBAL               CDS = 0x00000008
+	CDS_SET_PRIMARY          CDS = 0x00000010
+	CDS_VIDEOPARAMETERS      CDS = 0x00000020
+	CDS_ENABLE_UNSAFE_MODES  CDS = 0x00000100
+	CDS_DISABLE_UNSAFE_MODES CDS = 0x00000200
+	CDS_NORESET              CDS = 0x10000000
+	CDS_RESET                CDS = 0x40000000
+)
+
+// DISP_CHANGE represents the result of ChangeDisplaySettingsExW. Every value
+// but DISP_CHANGE_SUCCESSFUL is returned as an error.
+type DISP_CHANGE int32
+
+// [DISP_CHANGE] constants.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-changedisplaysettingsexw#return-value
+const (
+	DISP_CHANGE_SUCCESSFUL  DISP_CHANGE = 0
+	DISP_CHANGE_RESTART     DISP_CHANGE = 1
+	DISP_CHANGE_FAILED      DISP_CHANGE = -1
+	DISP_CHANGE_BADMODE     DISP_CHANGE = -2
+	DISP_CHANGE_NOTUPDATED  DISP_CHANGE = -3
+	DISP_CHANGE_BADFLAGS    DISP_CHANGE = -4
+	DISP_CHANGE_BADPARAM    DISP_CHANGE = -5
+	DISP_CHANGE_BADDUALVIEW DISP_CHANGE = -6
+)
+
+// Error implements the error interface.
+func (e DISP_CHANGE) Error() string {
+	switch e {
+	case DISP_CHANGE_SUCCESSFUL:
+		return "winapi: display mode changed"
+	case DISP_CHANGE_RESTART:
+		return "winapi: display mode change requires a restart"
+	case DISP_CHANGE_FAILED:
+		return "winapi: display driver failed the display mode"
+	case DISP_CHANGE_BADMODE:
+		return "winapi: display mode not supported"
+	case DISP_CHANGE_NOTUPDATED:
+		return "winapi: display mode not written to the registry"
+	case DISP_CHANGE_BADFLAGS:
+		return "winapi: invalid display mode change flags"
+	case DISP_CHANGE_BADPARAM:
+		return "winapi: invalid display mode change parameter"
+	case DISP_CHANGE_BADDUALVIEW:
+		return "winapi: display mode not supported by DualView"
+	}
+
+	return fmt.Sprintf("winapi: display mode change failed (%d)", int32(e))
+}
+
+// ENUM_CURRENT_SETTINGS and ENUM_REGISTRY_SETTINGS are the mode numbers of
+// EnumDisplaySettingsExW that retrieve the current display mode and the one
+// stored in the registry.
+const (
+	ENUM_CURRENT_SETTINGS  = 0xFFFFFFFF
+	ENUM_REGISTRY_SETTINGS = 0xFFFFFFFE
+)
+
+// EDS represents which display modes EnumDisplaySettingsExW enumerates.
+type EDS uint32
+
+// [EDS] constants.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-enumdisplaysettingsexw#parameters
+const (
+	EDS_RAWMODE     EDS = 0x00000002
+	EDS_ROTATEDMODE EDS = 0x00000004
+)
+
+// EDD_GET_DEVICE_INTERFACE_NAME is the flag of EnumDisplayDevicesW that
+// retrieves the device interface name of a monitor as its DeviceID.
+const EDD_GET_DEVICE_INTERFACE_NAME = 0x00000001
+
+// DISPLAY_DEVICE represents the state of a display adapter or monitor.
+type DISPLAY_DEVICE uint32
+
+// [DISPLAY_DEVICE] constants. DISPLAY_DEVICE_ACTIVE and
+// DISPLAY_DEVICE_ATTACHED apply to monitors, the others to adapters.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/wingdi/ns-wingdi-display_devicew#members
+const (
+	DISPLAY_DEVICE_ATTACHED_TO_DESKTOP DISPLAY_DEVICE = 0x00000001
+	DISPLAY_DEVICE_MULTI_DRIVER        DISPLAY_DEVICE = 0x00000002
+	DISPLAY_DEVICE_PRIMARY_DEVICE      DISPLAY_DEVICE = 0x00000004
+	DISPLAY_DEVICE_MIRRORING_DRIVER    DISPLAY_DEVICE = 0x00000008
+	DISPLAY_DEVICE_VGA_COMPATIBLE      DISPLAY_DEVICE = 0x00000010
+	DISPLAY_DEVICE_REMOVABLE           DISPLAY_DEVICE = 0x00000020
+	DISPLAY_DEVICE_MODESPRUNED         DISPLAY_DEVICE = 0x08000000
+	DISPLAY_DEVICE_ACTIVE              DISPLAY_DEVICE = 0x00000001
+	DISPLAY_DEVICE_ATTACHED            DISPLAY_DEVICE = 0x00000002
+)
+
+// A DEVMODEW is a struct that contains information about the initialization
+// and environment of a printer or a display device. Only the display members
+// of its unions are declared.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/wingdi/ns-wingdi-devmodew
+type DEVMODEW struct {
+	// DeviceName is the name of the device.
+	DeviceName [32]uint16 // (WCHAR)
+
+	// SpecVersion is the version of the struct, DM_SPECVERSION.
+	SpecVersion uint16 // (WORD)
+
+	// DriverVersion is the driver version number assigned by the driver
+	// developer.
+	DriverVersion uint16 // (WORD)
+
+	// Size is the size of the struct, in bytes, without the private driver
+	// data that follows it.
+	Size uint16 // (WORD)
+
+	// DriverExtra is the size of the private driver data, in bytes.
+	DriverExtra uint16 // (WORD)
+
+	// Fields holds the [DM] flags of the members that are set.
+	Fields DM // (DWORD)
+
+	// Position is the position of the display on the virtual screen.
+	Position POINT // (POINTL)
+
+	// DisplayOrientation is the orientation of the display.
+	DisplayOrientation DMDO // (DWORD)
+
+	// DisplayFixedOutput is how a low resolution mode is presented on a
+	// display of higher resolution.
+	DisplayFixedOutput uint32 // (DWORD)
+
+	Color       int16      // (short)
+	Duplex      int16      // (short)
+	YResolution int16      // (short)
+	TTOption    int16      // (short)
+	Collate     int16      // (short)
+	FormName    [32]uint16 // (WCHAR)
+	LogPixels   uint16     // (WORD)
+
+	// BitsPerPel is the color resolution of the display, in bits per pixel.
+	BitsPerPel uint32 // (DWORD)
+
+	// PelsWidth is the width of the display, in pixels.
+	PelsWidth uint32 // (DWORD)
+
+	// PelsHeight is the height of the display, in pixels.
+	PelsHeight uint32 // (DWORD)
+
+	// DisplayFlags is the display mode, such as interlaced.
+	DisplayFlags uint32 // (DWORD)
+
+	// DisplayFrequency is the refresh rate of the display, in hertz.
+	DisplayFrequency uint32 // (DWORD)
+
+	ICMMethod     uint32 // (DWORD)
+	ICMIntent     uint32 // (DWORD)
+	MediaType     uint32 // (DWORD)
+	DitherType    uint32 // (DWORD)
+	Reserved1     uint32 // (DWORD)
+	Reserved2     uint32 // (DWORD)
+	PanningWidth  uint32 // (DWORD)
+	PanningHeight uint32 // (DWORD)
+}
+
+// A DISPLAY_DEVICEW is a struct that contains information about a display
+// adapter or a monitor, filled in by EnumDisplayDevicesW.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/wingdi/ns-wingdi-display_devicew
+type DISPLAY_DEVICEW struct {
+	// Size is the size of the struct, in bytes, which must be set before
+	// calling EnumDisplayDevicesW.
+	Size uint32 // (DWORD)
+
+	// DeviceName is the name of the adapter, such as `\\.\DISPLAY1`, or of
+	// the monitor, such as `\\.\DISPLAY1\Monitor0`.
+	DeviceName [32]uint16 // (WCHAR)
+
+	// DeviceString is the description of the adapter or monitor.
+	DeviceString [128]uint16 // (WCHAR)
+
+	// StateFlags holds the state of the adapter or monitor.
+	StateFlags DISPLAY_DEVICE // (DWORD)
+
+	// DeviceID is the Plug and Play id of the device, or its interface name
+	// with EDD_GET_DEVICE_INTERFACE_NAME.
+	DeviceID [128]uint16 // (WCHAR)
+
+	// DeviceKey is the registry key of the device.
+	DeviceKey [128]uint16 // (WCHAR)
+}
+
+// A DisplayDevice describes a display adapter, or a monitor attached to one,
+// as enumerated by EnumDisplayDevicesW.
+type DisplayDevice struct {
+	// Name is the device name, which identifies an adapter in the display
+	// mode functions, such as `\\.\DISPLAY1`.
+	Name string
+
+	// Description is the name of the adapter or monitor model.
+	Description string
+
+	// ID is the Plug and Play id of the device.
+	ID string
+
+	// Flags is the state of the device.
+	Flags DISPLAY_DEVICE
+}
+
+// DisplayDevice returns the [DisplayDevice] that d describes.
+func (d *DISPLAY_DEVICEW) DisplayDevice() DisplayDevice {
+	return DisplayDevice{
+		Name:        utf16String(d.DeviceName[:]),
+		Description: utf16String(d.DeviceString[:]),
+		ID:          utf16String(d.DeviceID[:]),
+		Flags:       d.StateFlags,
+	}
+}
+
+// A DisplayMode is the resolution, color depth, refresh rate, orientation and
+// position of a display.
+type DisplayMode struct {
+	// Width and Height are the resolution of the display, in pixels, as it is
+	// oriented: a 1920x1080 display turned by DMDO_90 is 1080 wide.
+	Width, Height uint32
+
+	// BitsPerPixel is the color depth of the display.
+	BitsPerPixel uint32
+
+	// Frequency is the refresh rate of the display, in hertz. 0 and 1 stand
+	// for the default refresh rate of the hardware.
+	Frequency uint32
+
+	// Orientation is the rotation of the display.
+	Orientation DMDO
+
+	// Position is the upper-left corner of the display on the virtual screen,
+	// which is the origin for the primary display.
+	Position POINT
+
+	// Fields holds the [DM] flags of the members that are set, as in a
+	// [DEVMODEW]. If it is 0, the members that are not 0 are set, so that a
+	// mode such as {Width: 1920, Height: 1080} keeps the color depth, refresh
+	// rate, orientation and position of the display.
+	Fields DM
+}
+
+// displayModeFields are the [DM] flags of the members of a [DisplayMode].
+const displayModeFields = DM_PELSWIDTH | DM_PELSHEIGHT | DM_BITSPERPEL |
+	DM_DISPLAYFREQUENCY | DM_DISPLAYORIENTATION | DM_POSITION
+
+// DisplayMode returns the [DisplayMode] that dm describes, with the Fields of
+// dm. Members that are not in dm.Fields are left 0.
+func (dm *DEVMODEW) DisplayMode() DisplayMode {
+	m := DisplayMode{Fields: dm.Fields & displayModeFields}
+	if m.Fields&DM_PELSWIDTH != 0 {
+		m.Width = dm.PelsWidth
+	}
+	if m.Fields&DM_PELSHEIGHT != 0 {
+		m.Height = dm.PelsHeight
+	}
+	if m.Fields&DM_BITSPERPEL != 0 {
+		m.BitsPerPixel = dm.BitsPerPel
+	}
+	if m.Fields&DM_DISPLAYFREQUENCY != 0 {
+		m.Frequency = dm.DisplayFrequency
+	}
+	if m.Fields&DM_DISPLAYORIENTATION != 0 {
+		m.Orientation = dm.DisplayOrientation
+	}
+	if m.Fields&DM_POSITION != 0 {
+		m.Position = dm.Position
+	}
+
+	return m
+}
+
+// DEVMODE returns the [DEVMODEW] that sets the members of the display mode m
+// that are set with ChangeDisplaySettingsExW.
+func (m DisplayMode) DEVMODE() DEVMODEW {
+	return DEVMODEW{
+		SpecVersion:        DM_SPECVERSION,
+		Size:               devModeSize,
+		Fields:             m.fields(),
+		Position:           m.Position,
+		DisplayOrientation: m.Orientation,
+		PelsWidth:          m.Width,
+		PelsHeight:         m.Height,
+		BitsPerPel:         m.BitsPerPixel,
+		DisplayFrequency:   m.Frequency,
+	}
+}
+
+// fields returns the [DM] flags of the members of m that are set.
+func (m DisplayMode) fields() DM {
+	if m.Fields != 0 {
+		return m.Fields & displayModeFields
+	}
+
+	var fields DM
+	for _, f := range []struct {
+		set bool
+		dm  DM
+	}{
+		{m.Width != 0, DM_PELSWIDTH},
+		{m.Height != 0, DM_PELSHEIGHT},
+		{m.BitsPerPixel != 0, DM_BITSPERPEL},
+		{m.Frequency != 0, DM_DISPLAYFREQUENCY},
+		{m.Orientation != DMDO_DEFAULT, DM_DISPLAYORIENTATION},
+		{m.Position != (POINT{}), DM_POSITION},
+	} {
+		if f.set {
+			fields |= f.dm
+		}
+	}
+
+	return fields
+}
+
+// ApplyTo returns the display mode dm, such as the current mode of a display,
+// with the members of m that are set replacing its own. If m turns the display
+// between landscape and portrait without setting its resolution, the width and
+// height of dm are swapped.
+func (m DisplayMode) ApplyTo(dm DEVMODEW) DEVMODEW {
+	set := m.DEVMODE()
+	if set.Fields&DM_DISPLAYORIENTATION != 0 && set.Fields&(DM_PELSWIDTH|DM_PELSHEIGHT) == 0 &&
+		m.Orientation.Portrait() != dm.DisplayOrientation.Portrait() {
+		dm.PelsWidth, dm.PelsHeight = dm.PelsHeight, dm.PelsWidth
+	}
+
+	for _, f := range []struct {
+		dm       DM
+		dst, src *uint32
+	}{
+		{DM_PELSWIDTH, &dm.PelsWidth, &set.PelsWidth},
+		{DM_PELSHEIGHT, &dm.PelsHeight, &set.PelsHeight},
+		{DM_BITSPERPEL, &dm.BitsPerPel, &set.BitsPerPel},
+		{DM_DISPLAYFREQUENCY, &dm.DisplayFrequency, &set.DisplayFrequency},
+		{DM_DISPLAYORIENTATION, (*uint32)(&dm.DisplayOrientation), (*uint32)(&set.DisplayOrientation)},
+	} {
+		if set.Fields&f.dm != 0 {
+			*f.dst = *f.src
+		}
+	}
+	if set.Fields&DM_POSITION != 0 {
+		dm.Position = set.Position
+	}
+	dm.Fields |= set.Fields
+
+	return dm
+}
+
+// Rotate returns m turned to the orientation o, with Width and Height swapped
+// if o turns the display between landscape and portrait. The orientation is
+// set even if o is DMDO_DEFAULT.
+func (m DisplayMode) Rotate(o DMDO) DisplayMode {
+	m.Fields = m.fields() | DM_DISPLAYORIENTATION
+	if m.Orientation.Portrait() != o.Portrait() {
+		m.Width, m.Height = m.Height, m.Width
+	}
+	m.Orientation = o
+
+	return m
+}
+
+// String returns m in the form "1920x1080 32bpp 60Hz", followed by the
+// rotation unless Orientation is DMDO_DEFAULT.
+func (m DisplayMode) String() string {
+	s := fmt.Sprintf("%dx%d %dbpp %dHz", m.Width, m.Height, m.BitsPerPixel, m.Frequency)
+	if m.Orientation != DMDO_DEFAULT {
+		s += fmt.Sprintf(" %d°", m.Orientation.Degrees())
+	}
+
+	return s
+}
+
+// A DisplayChange is the display mode announced by a WM_DISPLAYCHANGE
+// message.
+type DisplayChange struct {
+	// Width and Height are the new resolution of the primary display.
+	Width, Height uint32
+
+	// BitsPerPixel is the new color depth of the display.
+	BitsPerPixel uint32
+}
+
+// ParseDisplayChange returns the display mode of a WM_DISPLAYCHANGE message:
+// the color depth in wParam, and the width and height in the low and high
+// words of lParam.
+func ParseDisplayChange(wParam, lParam uintptr) DisplayChange {
+	return DisplayChange{
+		Width:        uint32(uint16(lParam)),
+		Height:       uint32(uint16(lParam >> 16)),
+		BitsPerPixel: uint32(wParam),
+	}
+}
+
+// #endregion
+
+// devModeSize is the size of a [DEVMODEW], in bytes.
+const devModeSize = uint16(unsafe.Sizeof(DEVMODEW{}))
+
+// utf16String decodes u, stopping at the first NUL.
+func utf16String(u []uint16) string {
+	for i, c := range u {
+		if c == 0 {
+			u = u[:i]
+			break
+		}
+	}
+
+	return string(utf16.Decode(u))
+}
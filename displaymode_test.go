@@ -0,0 +1,183 @@
+package winapi_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/kamaranl/gotools/test"
+	"github.com/kamaranl/winapi"
+)
+
+func TestDisplayMode(t *testing.T) {
+	tName := "DisplayMode"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	scenes := []test.Scene{
+		{
+			Input:  winapi.DisplayMode{Width: 1920, Height: 1080, BitsPerPixel: 32, Frequency: 60},
+			Output: "1920x1080 32bpp 60Hz",
+		},
+		{
+			Input: winapi.DisplayMode{
+				Width: 1080, Height: 1920, BitsPerPixel: 32, Frequency: 144,
+				Orientation: winapi.DMDO_90, Position: winapi.POINT{X: -1080, Y: -420},
+			},
+			Output: "1080x1920 32bpp 144Hz 90°",
+		},
+		{
+			Input:  winapi.DisplayMode{Width: 800, Height: 600, Orientation: winapi.DMDO_180},
+			Output: "800x600 0bpp 0Hz 180°",
+		},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			m := s.Input.(winapi.DisplayMode)
+			if got := m.String(); got != s.Output.(string) {
+				t.Errorf(test.ErrWantFGotF, s.Output, got)
+			}
+
+			dm := m.DEVMODE()
+			if dm.Size != 220 || dm.SpecVersion != winapi.DM_SPECVERSION {
+				t.Errorf(test.ErrWantFGotF, 220, dm.Size)
+			}
+			want := m
+			want.Fields = dm.Fields
+			if got := dm.DisplayMode(); got != want {
+				t.Errorf(test.ErrWantFGotF, want, got)
+			}
+			if m.BitsPerPixel == 0 && dm.Fields&winapi.DM_BITSPERPEL != 0 {
+				t.Errorf(test.ErrUnexpectedF, "DM_BITSPERPEL")
+			}
+			if m.Position == (winapi.POINT{}) && dm.Fields&winapi.DM_POSITION != 0 {
+				t.Errorf(test.ErrUnexpectedF, "DM_POSITION")
+			}
+		})
+	}
+
+	m := winapi.DisplayMode{Width: 1920, Height: 1080, Orientation: winapi.DMDO_180}
+	for _, o := range []winapi.DMDO{winapi.DMDO_90, winapi.DMDO_270, winapi.DMDO_DEFAULT} {
+		m = m.Rotate(o)
+		want := winapi.DisplayMode{
+			Width: 1920, Height: 1080, Orientation: o,
+			Fields: winapi.DM_PELSWIDTH | winapi.DM_PELSHEIGHT | winapi.DM_DISPLAYORIENTATION,
+		}
+		if o.Portrait() {
+			want.Width, want.Height = 1080, 1920
+		}
+		if m != want {
+			t.Errorf(test.ErrWantFGotF, want, m)
+		}
+	}
+}
+
+func TestDisplayModeApplyTo(t *testing.T) {
+	tName := "DisplayModeApplyTo"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	// A secondary display turned to portrait, as EnumDisplaySettingsExW
+	// reports its current mode.
+	current := winapi.DisplayMode{
+		Width: 1080, Height: 1920, BitsPerPixel: 32, Frequency: 60,
+		Orientation: winapi.DMDO_90, Position: winapi.POINT{X: 1920},
+		Fields: winapi.DM_PELSWIDTH | winapi.DM_PELSHEIGHT | winapi.DM_BITSPERPEL |
+			winapi.DM_DISPLAYFREQUENCY | winapi.DM_DISPLAYORIENTATION | winapi.DM_POSITION,
+	}
+	with := func(f func(*winapi.DisplayMode)) winapi.DisplayMode {
+		m := current
+		f(&m)
+		return m
+	}
+
+	scenes := []test.Scene{
+		{
+			Input:  winapi.DisplayMode{Width: 1200, Height: 1920},
+			Output: with(func(m *winapi.DisplayMode) { m.Width = 1200 }),
+		},
+		{
+			Input:  winapi.DisplayMode{Frequency: 75},
+			Output: with(func(m *winapi.DisplayMode) { m.Frequency = 75 }),
+		},
+		{
+			Input: winapi.DisplayMode{}.Rotate(winapi.DMDO_DEFAULT),
+			Output: with(func(m *winapi.DisplayMode) {
+				m.Width, m.Height, m.Orientation = 1920, 1080, winapi.DMDO_DEFAULT
+			}),
+		},
+		{
+			Input:  winapi.DisplayMode{Fields: winapi.DM_POSITION},
+			Output: with(func(m *winapi.DisplayMode) { m.Position = winapi.POINT{} }),
+		},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			dm := s.Input.(winapi.DisplayMode).ApplyTo(current.DEVMODE())
+			if got := dm.DisplayMode(); got != s.Output.(winapi.DisplayMode) {
+				t.Errorf(test.ErrWantFGotF, s.Output, got)
+			}
+		})
+	}
+}
+
+func TestDisplayDevice(t *testing.T) {
+	tName := "DisplayDevice"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	var dd winapi.DISPLAY_DEVICEW
+	copy(dd.DeviceName[:], []uint16{'\\', '\\', '.', '\\', 'D', 'I', 'S', 'P', 'L', 'A', 'Y', '1'})
+	copy(dd.DeviceString[:], []uint16{'G', 'P', 'U', 0, 'X'})
+	dd.StateFlags = winapi.DISPLAY_DEVICE_ATTACHED_TO_DESKTOP | winapi.DISPLAY_DEVICE_PRIMARY_DEVICE
+
+	want := winapi.DisplayDevice{
+		Name:        `\\.\DISPLAY1`,
+		Description: "GPU",
+		Flags:       winapi.DISPLAY_DEVICE_ATTACHED_TO_DESKTOP | winapi.DISPLAY_DEVICE_PRIMARY_DEVICE,
+	}
+	if got := dd.DisplayDevice(); got != want {
+		t.Errorf(test.ErrWantFGotF, want, got)
+	}
+}
+
+func TestParseDisplayChange(t *testing.T) {
+	tName := "ParseDisplayChange"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	scenes := []test.Scene{
+		{Input: [2]uintptr{32, 1080<<16 | 1920}, Output: winapi.DisplayChange{Width: 1920, Height: 1080, BitsPerPixel: 32}},
+		{Input: [2]uintptr{24, 3840<<16 | 2160}, Output: winapi.DisplayChange{Width: 2160, Height: 3840, BitsPerPixel: 24}},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			in := s.Input.([2]uintptr)
+			if got := winapi.ParseDisplayChange(in[0], in[1]); got != s.Output.(winapi.DisplayChange) {
+				t.Errorf(test.ErrWantFGotF, s.Output, got)
+			}
+		})
+	}
+}
+
+func TestDISP_CHANGE(t *testing.T) {
+	tName := "DISP_CHANGE"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	var err error = winapi.DISP_CHANGE_BADMODE
+	if !errors.Is(fmt.Errorf("kiosk: %w", err), winapi.DISP_CHANGE_BADMODE) {
+		t.Errorf(test.ErrWantFGotF, winapi.DISP_CHANGE_BADMODE, err)
+	}
+	if got := winapi.DISP_CHANGE(-42).Error(); got != "winapi: display mode change failed (-42)" {
+		t.Errorf(test.ErrUnexpectedF, got)
+	}
+}
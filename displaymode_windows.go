@@ -0,0 +1,254 @@
+//go:build windows
+
+package winapi
+
+import (
+	"io"
+	"sync"
+	"time"
+)
+
+// DisplayDevices returns the display adapters, if adapter is "", or the
+// monitors attached to the display adapter adapter.
+// It returns an error if adapter does not exist.
+//
+// Experimental: DisplayDevices has not been tested or used internally.
+func DisplayDevices(adapter string) ([]DisplayDevice, error) {
+	var devices []DisplayDevice
+	for i := uint32(0); ; i++ {
+		var dd DISPLAY_DEVICEW
+		if err := EnumDisplayDevicesW(adapter, i, &dd, 0); err != nil {
+			if i == 0 && adapter != "" {
+				return nil, err
+			}
+			break
+		}
+
+		devices = append(devices, dd.DisplayDevice())
+	}
+
+	return devices, nil
+}
+
+// DisplayModes returns the display modes the display adapter device, or ""
+// for the primary one, supports in its current orientation, as the graphics
+// driver lists them.
+// It returns an error if device does not exist.
+//
+// Experimental: DisplayModes has not been tested or used internally.
+func DisplayModes(device string) ([]DisplayMode, error) {
+	var modes []DisplayMode
+	for i := uint32(0); ; i++ {
+		var dm DEVMODEW
+		if err := EnumDisplaySettingsExW(device, i, &dm, 0); err != nil {
+			if i == 0 {
+				return nil, err
+			}
+			break
+		}
+
+		modes = append(modes, dm.DisplayMode())
+	}
+
+	return modes, nil
+}
+
+// CurrentDisplayMode returns the display mode of the display adapter device,
+// or "" for the primary one.
+// It returns an error if device does not exist or is not attached to the
+// desktop.
+//
+// Experimental: CurrentDisplayMode has not been tested or used internally.
+func CurrentDisplayMode(device string) (DisplayMode, error) {
+	var dm DEVMODEW
+	if err := EnumDisplaySettingsExW(device, ENUM_CURRENT_SETTINGS, &dm, 0); err != nil {
+		return DisplayMode{}, err
+	}
+
+	return dm.DisplayMode(), nil
+}
+
+// A DisplayModeChange is a display mode set by [SetDisplayMode], which can be
+// confirmed or reverted to the mode the display had before.
+type DisplayModeChange struct {
+	device   string
+	mode     DEVMODEW
+	previous DEVMODEW
+
+	mu        sync.Mutex
+	timer     *time.Timer
+	persisted bool
+	reverted  bool
+}
+
+// SetDisplayMode sets the display mode of the display adapter device, or ""
+// for the primary one, to its current mode with the members of mode that are
+// set, as [DisplayMode.ApplyTo] does, after validating it with CDS_TEST. The
+// change is not written to the registry, so it lasts until the next sign-in,
+// unless it is confirmed with persist. If rollback is not 0, the previous
+// mode is restored once it has elapsed unless the change is confirmed first,
+// as the Display settings do when a mode leaves the screen blank.
+// It returns nil with the [DISP_CHANGE] result as an error if mode is not
+// valid or cannot be set.
+//
+// Experimental: SetDisplayMode has not been tested or used internally.
+func SetDisplayMode(device string, mode DisplayMode, rollback time.Duration) (*DisplayModeChange, error) {
+	c := &DisplayModeChange{device: device}
+	if err := EnumDisplaySettingsExW(device, ENUM_CURRENT_SETTINGS, &c.previous, 0); err != nil {
+		return nil, err
+	}
+	c.mode = mode.ApplyTo(c.previous)
+
+	if err := ChangeDisplaySettingsExW(device, &c.mode, CDS_TEST); err != nil {
+		return nil, err
+	}
+	if err := ChangeDisplaySettingsExW(device, &c.mode, 0); err != nil {
+		return nil, err
+	}
+
+	if rollback > 0 {
+		c.mu.Lock()
+		c.timer = time.AfterFunc(rollback, func() { c.Revert() })
+		c.mu.Unlock()
+	}
+
+	return c, nil
+}
+
+// Previous returns the display mode the display had before the change.
+//
+// Experimental: Previous has not been tested or used internally.
+func (c *DisplayModeChange) Previous() DisplayMode {
+	return c.previous.DisplayMode()
+}
+
+// Confirm keeps the display mode, stopping the rollback timer. If persist is
+// set, the mode is also written to the registry, so that it survives a
+// restart.
+// It returns [ErrDisplayModeReverted] if the change was already reverted, or
+// an error if the mode cannot be written to the registry.
+//
+// Experimental: Confirm has not been tested or used internally.
+func (c *DisplayModeChange) Confirm(persist bool) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.reverted {
+		return ErrDisplayModeReverted
+	}
+	if c.timer != nil {
+		c.timer.Stop()
+	}
+
+	if persist && !c.persisted {
+		if err := ChangeDisplaySettingsExW(c.device, &c.mode, CDS_UPDATEREGISTRY); err != nil {
+			return err
+		}
+		c.persisted = true
+	}
+
+	return nil
+}
+
+// Revert restores the display mode the display had before the change, in the
+// registry too if the change was confirmed with persist. Reverting twice does
+// nothing.
+// It returns an error if the previous mode cannot be restored.
+//
+// Experimental: Revert has not been tested or used internally.
+func (c *DisplayModeChange) Revert() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.reverted {
+		return nil
+	}
+	if c.timer != nil {
+		c.timer.Stop()
+	}
+
+	var flags CDS
+	if c.persisted {
+		flags = CDS_UPDATEREGISTRY
+	}
+	if err := ChangeDisplaySettingsExW(c.device, &c.previous, flags); err != nil {
+		return err
+	}
+	c.reverted = true
+
+	return nil
+}
+
+// A DisplayWatcher receives the WM_DISPLAYCHANGE messages broadcast when the
+// resolution, color depth or orientation of a display changes, through a
+// hidden top-level window, which unlike a message-only window receives them.
+type DisplayWatcher struct {
+	win       *msgWindow
+	events    chan DisplayChange
+	closed    chan struct{}
+	closeOnce sync.Once
+	closeErr  error
+}
+
+// NewDisplayWatcher opens a [DisplayWatcher]. Changes are buffered, and those
+// that arrive while the buffer is full are dropped rather than holding up the
+// broadcast, so call [CurrentDisplayMode] after Read for the settled mode.
+// It returns nil with an error if its window cannot be created.
+//
+// Experimental: NewDisplayWatcher has not been tested or used internally.
+func NewDisplayWatcher() (*DisplayWatcher, error) {
+	w := &DisplayWatcher{
+		events: make(chan DisplayChange, 16),
+		closed: make(chan struct{}),
+	}
+
+	win, err := startHiddenWindow(
+		func(hwnd HWND, msg MsgId, wParam, lParam uintptr) (uintptr, bool) {
+			if msg != WM_DISPLAYCHANGE {
+				return 0, false
+			}
+
+			select {
+			case w.events <- ParseDisplayChange(wParam, lParam):
+			default:
+			}
+
+			return 0, true
+		},
+		func(HWND) error { return nil },
+		func(HWND) error { return nil },
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	w.win = win
+	return w, nil
+}
+
+// Read blocks until the next display change is received.
+// It returns [io.EOF] once the watcher is closed.
+//
+// Experimental: Read has not been tested or used internally.
+func (w *DisplayWatcher) Read() (DisplayChange, error) {
+	select {
+	case dc := <-w.events:
+		return dc, nil
+	case <-w.closed:
+		return DisplayChange{}, io.EOF
+	}
+}
+
+// Close destroys the window of the watcher. Pending and subsequent calls to
+// Read return [io.EOF].
+// It returns an error if the window cannot be destroyed.
+//
+// Experimental: Close has not been tested or used internally.
+func (w *DisplayWatcher) Close() error {
+	w.closeOnce.Do(func() {
+		close(w.closed)
+		w.closeErr = w.win.close()
+	})
+
+	return w.closeErr
+}
@@ -0,0 +1,36 @@
+//go:build windows
+
+package winapi
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+var (
+	dwmapi                    = syscall.NewLazyDLL("dwmapi.dll")
+	procDwmGetWindowAttribute = dwmapi.NewProc("DwmGetWindowAttribute")
+)
+
+// DwmGetWindowAttribute retrieves the window attribute attr of the window
+// hwnd into the size bytes at value, such as a [RECT] for
+// DWMWA_EXTENDED_FRAME_BOUNDS.
+// It returns an error if the call fails.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/dwmapi/nf-dwmapi-dwmgetwindowattribute
+//
+// Experimental: DwmGetWindowAttribute has not been tested or used internally.
+func DwmGetWindowAttribute(hwnd HWND, attr DWMWA, value unsafe.Pointer, size uint32) error {
+	if err := procDwmGetWindowAttribute.Find(); err != nil {
+		return err
+	}
+
+	hr, _, _ := procDwmGetWindowAttribute.Call(
+		uintptr(hwnd),
+		uintptr(attr),
+		uintptr(value),
+		uintptr(size),
+	)
+
+	return hresult(hr)
+}
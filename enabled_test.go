@@ -0,0 +1,61 @@
+package winapi_test
+
+var enabled = map[string]bool{
+	"NewMouseInput":        true,
+	"NewKeybdInput":        true,
+	"Layout":               true,
+	"InputQueuePlan":       true,
+	"PartialInputError":    true,
+	"NewInput":             true,
+	"NewHardwareInput":     true,
+	"SendInputMi":          true,
+	"SendInputKi":          true,
+	"KnownFolderByName":    true,
+	"KnownFolderName":      true,
+	"SEErr":                true,
+	"EncodePathList":       true,
+	"DecodePathList":       true,
+	"ReadShortcut":         true,
+	"ParseShellLink":       true,
+	"EncodeICO":            true,
+	"DecodeICO":            true,
+	"HookKeyboard":         true,
+	"IsOwnInjection":       true,
+	"InjectionTag":         true,
+	"DecodeRawInput":       true,
+	"DecodeRawInputBuffer": true,
+	"InputLedger":          true,
+	"ParseMacro":           true,
+	"MacroDryRun":          true,
+	"FuzzParseMacro":       true,
+	"EventLogCodec":        true,
+	"DecodeEventLog":       true,
+	"EventLogCompile":      true,
+	"LangIDForTag":         true,
+	"LangIDTag":            true,
+	"ParseKLID":            true,
+	"HKL":                  true,
+	"KeyTranslator":        true,
+	"KeyboardState":        true,
+	"BuiltinLayout":        true,
+	"LayoutKeyStroke":      true,
+	"LayoutScanCode":       true,
+	"LayoutTypeEvents":     true,
+	"KeyEncoder":           true,
+	"ScanDown":             true,
+	"KeyLParam":            true,
+	"PointLParam":          true,
+	"MouseWParam":          true,
+	"MonitorConversion":    true,
+	"NormalizePoint":       true,
+	"DisplayMode":          true,
+	"DisplayDevice":        true,
+	"ParseDisplayChange":   true,
+	"DISP_CHANGE":          true,
+	"GridFor":              true,
+	"GridCell":             true,
+	"CenterRect":           true,
+	"DisplayModeApplyTo":   true,
+	"BlockInputDiagnosis":  true,
+	"InputBlocker":         true,
+}
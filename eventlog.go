@@ -0,0 +1,541 @@
+package winapi
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// ErrEventLogFormat is returned when data is not a valid input event log.
+var ErrEventLogFormat = errors.New("winapi: invalid event log")
+
+// EventLogVersion is the version of the event log format written by
+// [EventLogEncoder]. Logs of later versions are rejected by [DecodeEventLog].
+const EventLogVersion = 1
+
+// eventLogMagic is the signature at the start of binary event logs.
+var eventLogMagic = []byte("WIEL")
+
+// EventLogFormat represents the encoding of an event log.
+type EventLogFormat uint8
+
+// [EventLogFormat] constants.
+const (
+	// EventLogJSON is JSON lines: a header object, then one object per event.
+	EventLogJSON EventLogFormat = iota
+
+	// EventLogBinary is a compact binary encoding with varint fields and
+	// event times relative to the previous event.
+	EventLogBinary
+)
+
+// An EventLog is a recording of keyboard and mouse input, as made by a
+// Recorder and replayed by a Player.
+type EventLog struct {
+	EventLogHeader
+
+	// Events are the events of the log, ordered by time.
+	Events []LogEvent
+}
+
+// An EventLogHeader describes the recording of an [EventLog].
+type EventLogHeader struct {
+	// Version is the version of the log format.
+	Version int
+
+	// Start is when the recording started.
+	Start time.Time
+
+	// Left, Top, Width and Height are the bounds of the virtual screen while
+	// recording, in pixels, which mouse positions are relative to.
+	Left, Top, Width, Height int32
+}
+
+// A LogEvent is a single input event of an [EventLog].
+type LogEvent struct {
+	// At is the time of the event, relative to the start of the recording.
+	At time.Duration
+
+	// Kind is the kind of event.
+	Kind LogKind
+
+	// Vk, Scan and Extended are the virtual key, scan code and extended-key
+	// flag of LogKeyDown and LogKeyUp.
+	Vk, Scan uint16
+	Extended bool
+
+	// X and Y are the position of the cursor for mouse events, in pixels of
+	// the virtual screen.
+	X, Y int32
+
+	// Button is the button of LogButtonDown and LogButtonUp.
+	Button MouseButton
+
+	// Delta is the wheel movement of LogWheel and LogHWheel, in multiples of
+	// 120 per notch.
+	Delta int32
+}
+
+// LogKind represents the kind of a [LogEvent].
+type LogKind uint8
+
+// [LogKind] constants.
+const (
+	LogKeyDown LogKind = iota + 1
+	LogKeyUp
+	LogMove
+	LogButtonDown
+	LogButtonUp
+	LogWheel
+	LogHWheel
+)
+
+// logKinds are the names of each [LogKind] in JSON logs.
+var logKinds = [...]string{
+	LogKeyDown:    "keydown",
+	LogKeyUp:      "keyup",
+	LogMove:       "move",
+	LogButtonDown: "buttondown",
+	LogButtonUp:   "buttonup",
+	LogWheel:      "wheel",
+	LogHWheel:     "hwheel",
+}
+
+// String returns the name of k, such as "keydown".
+func (k LogKind) String() string {
+	if int(k) < len(logKinds) && logKinds[k] != "" {
+		return logKinds[k]
+	}
+
+	return fmt.Sprintf("kind%d", k)
+}
+
+// isKey reports whether k is a keyboard event.
+func (k LogKind) isKey() bool {
+	return k == LogKeyDown || k == LogKeyUp
+}
+
+// jsonLogHeader is the first line of a JSON event log.
+type jsonLogHeader struct {
+	Version int       `json:"version"`
+	Start   time.Time `json:"start"`
+	Left    int32     `json:"left"`
+	Top     int32     `json:"top"`
+	Width   int32     `json:"width"`
+	Height  int32     `json:"height"`
+}
+
+// jsonLogEvent is an event line of a JSON event log.
+type jsonLogEvent struct {
+	T        int64  `json:"t"` // in nanoseconds
+	Kind     string `json:"kind"`
+	Vk       uint16 `json:"vk,omitempty"`
+	Scan     uint16 `json:"scan,omitempty"`
+	Extended bool   `json:"ext,omitempty"`
+	X        int32  `json:"x,omitempty"`
+	Y        int32  `json:"y,omitempty"`
+	Button   string `json:"button,omitempty"`
+	Delta    int32  `json:"delta,omitempty"`
+}
+
+// An EventLogEncoder writes an event log one event at a time, so that a
+// recording is not lost if it is cut short.
+type EventLogEncoder struct {
+	w      io.Writer
+	format EventLogFormat
+	last   time.Duration
+	buf    []byte
+}
+
+// NewEventLogEncoder returns an [EventLogEncoder] that writes a log with
+// header h to w in format. The Version of h is ignored, and
+// [EventLogVersion] is written instead.
+// It returns an error if format is unknown or the header cannot be written.
+//
+// Experimental: NewEventLogEncoder has not been tested or used internally.
+func NewEventLogEncoder(w io.Writer, format EventLogFormat, h EventLogHeader) (*EventLogEncoder, error) {
+	e := &EventLogEncoder{w: w, format: format}
+
+	switch format {
+	case EventLogJSON:
+		line, err := json.Marshal(jsonLogHeader{EventLogVersion, h.Start, h.Left, h.Top, h.Width, h.Height})
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(append(line, '\n')); err != nil {
+			return nil, err
+		}
+	case EventLogBinary:
+		b := append([]byte(nil), eventLogMagic...)
+		b = binary.AppendUvarint(b, EventLogVersion)
+		b = binary.AppendVarint(b, h.Start.UnixNano())
+		b = binary.AppendVarint(b, int64(h.Left))
+		b = binary.AppendVarint(b, int64(h.Top))
+		b = binary.AppendVarint(b, int64(h.Width))
+		b = binary.AppendVarint(b, int64(h.Height))
+		if _, err := w.Write(b); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("winapi: unknown event log format %d", format)
+	}
+
+	return e, nil
+}
+
+// Encode writes ev to the log.
+// It returns an error wrapping [ErrEventLogFormat] if ev is invalid or earlier
+// than the previous event, or the error of writing.
+//
+// Experimental: Encode has not been tested or used internally.
+func (e *EventLogEncoder) Encode(ev LogEvent) error {
+	if err := checkLogEvent(ev); err != nil {
+		return err
+	}
+	if ev.At < e.last {
+		return fmt.Errorf("%w: event at %v before previous event at %v", ErrEventLogFormat, ev.At, e.last)
+	}
+
+	if e.format == EventLogJSON {
+		je := jsonLogEvent{T: int64(ev.At), Kind: ev.Kind.String()}
+		if ev.Kind.isKey() {
+			je.Vk, je.Scan, je.Extended = ev.Vk, ev.Scan, ev.Extended
+		} else {
+			je.X, je.Y = ev.X, ev.Y
+		}
+		switch ev.Kind {
+		case LogButtonDown, LogButtonUp:
+			je.Button = ev.Button.String()
+		case LogWheel, LogHWheel:
+			je.Delta = ev.Delta
+		}
+
+		line, err := json.Marshal(je)
+		if err != nil {
+			return err
+		}
+		if _, err := e.w.Write(append(line, '\n')); err != nil {
+			return err
+		}
+		e.last = ev.At
+
+		return nil
+	}
+
+	b := e.buf[:0]
+	b = binary.AppendUvarint(b, uint64(ev.At-e.last))
+	b = append(b, byte(ev.Kind))
+	if ev.Kind.isKey() {
+		b = binary.AppendUvarint(b, uint64(ev.Vk))
+		b = binary.AppendUvarint(b, uint64(ev.Scan))
+		if ev.Extended {
+			b = append(b, 1)
+		} else {
+			b = append(b, 0)
+		}
+	} else {
+		b = binary.AppendVarint(b, int64(ev.X))
+		b = binary.AppendVarint(b, int64(ev.Y))
+	}
+	switch ev.Kind {
+	case LogButtonDown, LogButtonUp:
+		b = append(b, byte(ev.Button))
+	case LogWheel, LogHWheel:
+		b = binary.AppendVarint(b, int64(ev.Delta))
+	}
+	e.buf = b
+
+	if _, err := e.w.Write(b); err != nil {
+		return err
+	}
+	e.last = ev.At
+
+	return nil
+}
+
+// EncodeEventLog writes l to w in format.
+// It returns an error wrapping [ErrEventLogFormat] if an event is invalid or
+// out of order, or the error of writing.
+//
+// Experimental: EncodeEventLog has not been tested or used internally.
+func EncodeEventLog(w io.Writer, l *EventLog, format EventLogFormat) error {
+	bw := bufio.NewWriter(w)
+
+	e, err := NewEventLogEncoder(bw, format, l.EventLogHeader)
+	if err != nil {
+		return err
+	}
+	for _, ev := range l.Events {
+		if err := e.Encode(ev); err != nil {
+			return err
+		}
+	}
+
+	return bw.Flush()
+}
+
+// DecodeEventLog reads an event log in either format from r. A log cut short
+// in the middle of an event, as left by an interrupted recording, is decoded
+// up to its last complete event.
+// It returns an error wrapping [ErrEventLogFormat] if the data is malformed or
+// of a later version than [EventLogVersion].
+//
+// Experimental: DecodeEventLog has not been tested or used internally.
+func DecodeEventLog(r io.Reader) (*EventLog, error) {
+	br := bufio.NewReader(r)
+
+	magic, err := br.Peek(len(eventLogMagic))
+	if err == nil && string(magic) == string(eventLogMagic) {
+		return decodeBinaryEventLog(br)
+	}
+
+	return decodeJSONEventLog(br)
+}
+
+func decodeJSONEventLog(br *bufio.Reader) (*EventLog, error) {
+	line, err := br.ReadBytes('\n')
+	if err != nil && (err != io.EOF || len(line) == 0) {
+		if err == io.EOF {
+			return nil, fmt.Errorf("%w: missing header", ErrEventLogFormat)
+		}
+		return nil, err
+	}
+
+	var h jsonLogHeader
+	if err := json.Unmarshal(line, &h); err != nil {
+		return nil, fmt.Errorf("%w: header: %v", ErrEventLogFormat, err)
+	}
+	if h.Version < 1 || h.Version > EventLogVersion {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrEventLogFormat, h.Version)
+	}
+
+	l := &EventLog{EventLogHeader: EventLogHeader{h.Version, h.Start, h.Left, h.Top, h.Width, h.Height}}
+	for n := 2; ; n++ {
+		line, err := br.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+
+		if len(line) > 0 {
+			ev, derr := decodeJSONLogEvent(line)
+			if derr == nil && len(l.Events) > 0 && ev.At < l.Events[len(l.Events)-1].At {
+				derr = fmt.Errorf("%w: event out of order", ErrEventLogFormat)
+			}
+			switch {
+			case derr == nil:
+				l.Events = append(l.Events, ev)
+			case line[len(line)-1] == '\n':
+				return nil, fmt.Errorf("line %d: %w", n, derr)
+			}
+			// An unterminated last line that is not a valid event is the
+			// remains of an interrupted write, and is dropped.
+		}
+		if err == io.EOF {
+			return l, nil
+		}
+	}
+}
+
+func decodeJSONLogEvent(line []byte) (LogEvent, error) {
+	var je jsonLogEvent
+	if err := json.Unmarshal(line, &je); err != nil {
+		return LogEvent{}, fmt.Errorf("%w: %v", ErrEventLogFormat, err)
+	}
+
+	ev := LogEvent{
+		At: time.Duration(je.T), Vk: je.Vk, Scan: je.Scan, Extended: je.Extended,
+		X: je.X, Y: je.Y, Delta: je.Delta,
+	}
+	for k, name := range logKinds {
+		if name != "" && name == je.Kind {
+			ev.Kind = LogKind(k)
+		}
+	}
+	if je.Button != "" {
+		b, ok := mouseButtonByName(je.Button)
+		if !ok {
+			return LogEvent{}, fmt.Errorf("%w: unknown button %q", ErrEventLogFormat, je.Button)
+		}
+		ev.Button = b
+	}
+
+	return ev, checkLogEvent(ev)
+}
+
+func decodeBinaryEventLog(br *bufio.Reader) (*EventLog, error) {
+	if _, err := br.Discard(len(eventLogMagic)); err != nil {
+		return nil, err
+	}
+
+	var bad error
+	uvarint := func() uint64 {
+		v, err := binary.ReadUvarint(br)
+		if err != nil && bad == nil {
+			bad = err
+		}
+		return v
+	}
+	varint := func() int64 {
+		v, err := binary.ReadVarint(br)
+		if err != nil && bad == nil {
+			bad = err
+		}
+		return v
+	}
+	readByte := func() byte {
+		c, err := br.ReadByte()
+		if err != nil && bad == nil {
+			bad = err
+		}
+		return c
+	}
+
+	l := &EventLog{}
+	l.Version = int(uvarint())
+	l.Start = time.Unix(0, varint())
+	l.Left, l.Top, l.Width, l.Height = int32(varint()), int32(varint()), int32(varint()), int32(varint())
+	if bad != nil {
+		return nil, fmt.Errorf("%w: truncated header", ErrEventLogFormat)
+	}
+	if l.Version < 1 || l.Version > EventLogVersion {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrEventLogFormat, l.Version)
+	}
+
+	var at time.Duration
+	for {
+		if _, err := br.Peek(1); err == io.EOF {
+			return l, nil
+		}
+
+		delta := uvarint()
+		ev := LogEvent{Kind: LogKind(readByte())}
+		if bad == nil && (ev.Kind < LogKeyDown || ev.Kind > LogHWheel) {
+			return nil, fmt.Errorf("%w: event %d: unknown event kind %d", ErrEventLogFormat, len(l.Events), ev.Kind)
+		}
+		if ev.Kind.isKey() {
+			ev.Vk, ev.Scan, ev.Extended = uint16(uvarint()), uint16(uvarint()), readByte() != 0
+		} else {
+			ev.X, ev.Y = int32(varint()), int32(varint())
+		}
+		switch ev.Kind {
+		case LogButtonDown, LogButtonUp:
+			ev.Button = MouseButton(readByte())
+		case LogWheel, LogHWheel:
+			ev.Delta = int32(varint())
+		}
+
+		if bad != nil {
+			if bad == io.EOF || bad == io.ErrUnexpectedEOF {
+				return l, nil
+			}
+			return nil, fmt.Errorf("%w: event %d: %v", ErrEventLogFormat, len(l.Events), bad)
+		}
+		if delta > uint64(1<<63-1-at) {
+			return nil, fmt.Errorf("%w: event %d: time overflow", ErrEventLogFormat, len(l.Events))
+		}
+		if err := checkLogEvent(ev); err != nil {
+			return nil, fmt.Errorf("event %d: %w", len(l.Events), err)
+		}
+
+		at += time.Duration(delta)
+		ev.At = at
+		l.Events = append(l.Events, ev)
+	}
+}
+
+// checkLogEvent checks that ev has a known kind and button.
+func checkLogEvent(ev LogEvent) error {
+	if ev.Kind < LogKeyDown || ev.Kind > LogHWheel {
+		return fmt.Errorf("%w: unknown event kind %d", ErrEventLogFormat, ev.Kind)
+	}
+	if ev.At < 0 {
+		return fmt.Errorf("%w: negative event time %v", ErrEventLogFormat, ev.At)
+	}
+	if int(ev.Button) >= len(mouseButtons) {
+		return fmt.Errorf("%w: unknown button %d", ErrEventLogFormat, ev.Button)
+	}
+
+	return nil
+}
+
+// PlayOptions are the options of replaying an [EventLog].
+type PlayOptions struct {
+	// Speed scales the pace of the replay: 2 replays twice as fast, 0.5 half
+	// as fast. A Speed of 0 or less replays at the recorded pace.
+	Speed float64
+
+	// Remap, if not nil, maps the recorded mouse positions, in pixels of the
+	// recorded virtual screen, to other positions in the same space. Positions
+	// are then scaled to the virtual screen of the replay, so that a recording
+	// made at one resolution replays at another.
+	Remap func(x, y int32) (int32, int32)
+}
+
+// Compile turns the events of l into an [InputQueue] that replays them with
+// opts. Mouse positions are sent as normalized absolute coordinates of the
+// virtual screen, relative to the screen bounds of l.
+// It returns an error wrapping [ErrEventLogFormat] if l has mouse events but
+// no screen bounds, or an invalid event.
+//
+// Experimental: Compile has not been tested or used internally.
+func (l *EventLog) Compile(opts PlayOptions) (*InputQueue, error) {
+	speed := opts.Speed
+	if speed <= 0 {
+		speed = 1
+	}
+
+	q := &InputQueue{}
+	for i, ev := range l.Events {
+		if err := checkLogEvent(ev); err != nil {
+			return nil, fmt.Errorf("event %d: %w", i, err)
+		}
+
+		var in INPUT
+		if ev.Kind.isKey() {
+			ki := KEYBDINPUT{Vk: ev.Vk, Scan: ev.Scan}
+			if ev.Extended {
+				ki.Flags |= KEYEVENTF_EXTENDEDKEY
+			}
+			if ev.Kind == LogKeyUp {
+				ki.Flags |= KEYEVENTF_KEYUP
+			}
+			in = NewInput(ki)
+		} else {
+			if l.Width <= 1 || l.Height <= 1 {
+				return nil, fmt.Errorf("%w: mouse event without screen bounds", ErrEventLogFormat)
+			}
+
+			x, y := ev.X, ev.Y
+			if opts.Remap != nil {
+				x, y = opts.Remap(x, y)
+			}
+
+			mi := MOUSEINPUT{
+				X:     normalizeCoord(x-l.Left, l.Width),
+				Y:     normalizeCoord(y-l.Top, l.Height),
+				Flags: MOUSEEVENTF_MOVE | MOUSEEVENTF_ABSOLUTE | MOUSEEVENTF_VIRTUALDESK,
+			}
+			switch ev.Kind {
+			case LogButtonDown, LogButtonUp:
+				b := ev.Button.event(ev.Kind == LogButtonUp)
+				mi.Flags |= b.Flags
+				mi.MouseData = b.MouseData
+			case LogWheel:
+				mi.Flags |= MOUSEEVENTF_WHEEL
+				mi.MouseData = MiData(ev.Delta)
+			case LogHWheel:
+				mi.Flags |= MOUSEEVENTF_HWHEEL
+				mi.MouseData = MiData(ev.Delta)
+			}
+			in = NewInput(mi)
+		}
+
+		q.AddAt(time.Duration(float64(ev.At)/speed), in)
+	}
+
+	return q, nil
+}
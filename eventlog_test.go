@@ -0,0 +1,183 @@
+package winapi_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kamaranl/gotools/test"
+	"github.com/kamaranl/winapi"
+)
+
+// eventLog returns a log with one event of each kind, recorded on two
+// 1920x1080 monitors side by side, the primary one on the right.
+func eventLog() *winapi.EventLog {
+	return &winapi.EventLog{
+		EventLogHeader: winapi.EventLogHeader{
+			Version: winapi.EventLogVersion,
+			Start:   time.Date(2026, 10, 19, 9, 30, 0, 123456789, time.UTC),
+			Left:    -1920, Top: 0, Width: 3840, Height: 1080,
+		},
+		Events: []winapi.LogEvent{
+			{At: 0, Kind: winapi.LogKeyDown, Vk: winapi.VK_RCONTROL, Scan: 0x1D, Extended: true},
+			{At: 40 * time.Millisecond, Kind: winapi.LogKeyUp, Vk: winapi.VK_RCONTROL, Scan: 0x1D, Extended: true},
+			{At: 40 * time.Millisecond, Kind: winapi.LogMove, X: -1920, Y: 1079},
+			{At: 1500 * time.Millisecond, Kind: winapi.LogButtonDown, X: 1919, Y: 0, Button: winapi.MouseX2},
+			{At: 1600 * time.Millisecond, Kind: winapi.LogButtonUp, X: 1919, Y: 0, Button: winapi.MouseX2},
+			{At: 2 * time.Second, Kind: winapi.LogWheel, X: 0, Y: 540, Delta: -240},
+			{At: 2*time.Second + 1, Kind: winapi.LogHWheel, X: 0, Y: 540, Delta: 120},
+		},
+	}
+}
+
+func TestEventLogCodec(t *testing.T) {
+	tName := "EventLogCodec"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	scenes := []test.Scene{
+		{Input: winapi.EventLogJSON, Passing: true},
+		{Input: winapi.EventLogBinary, Passing: true},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			want := eventLog()
+
+			var buf bytes.Buffer
+			if err := winapi.EncodeEventLog(&buf, want, s.Input.(winapi.EventLogFormat)); err != nil {
+				t.Fatalf(test.ErrUnexpectedF, err)
+			}
+			data := buf.Bytes()
+
+			got, err := winapi.DecodeEventLog(bytes.NewReader(data))
+			if err != nil {
+				t.Fatalf(test.ErrUnexpectedF, err)
+			}
+			if !got.Start.Equal(want.Start) {
+				t.Errorf(test.ErrWantFGotF, want.Start, got.Start)
+			}
+			got.Start = want.Start
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf(test.ErrWantFGotF, want, got)
+			}
+
+			// A log cut short keeps its complete events.
+			for n := len(data) - 1; n > len(data)-12; n-- {
+				got, err := winapi.DecodeEventLog(bytes.NewReader(data[:n]))
+				if err != nil {
+					t.Fatalf("cut at %d: "+test.ErrUnexpectedF, n, err)
+				}
+				if k := len(got.Events); k < len(want.Events)-2 || k > len(want.Events) ||
+					!reflect.DeepEqual(got.Events, want.Events[:k]) {
+					t.Errorf("cut at %d: "+test.ErrWantFGotF, n, want.Events, got.Events)
+				}
+			}
+		})
+	}
+}
+
+func TestDecodeEventLog(t *testing.T) {
+	tName := "DecodeEventLog"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	header := `{"version":1,"start":"2026-10-19T09:30:00Z","left":0,"top":0,"width":800,"height":600}` + "\n"
+
+	scenes := []test.Scene{
+		{Input: header + `{"t":5,"kind":"buttondown","x":1,"y":2,"button":"right"}` + "\n", Output: 1, Passing: true},
+		{Input: header + `{"t":5,"kind":"keydown","vk":65}` + "\n" + `{"t":5,"kind":"ke`, Output: 1, Passing: true},
+		{Input: header, Output: 0, Passing: true},
+		{Input: "", Passing: false},
+		{Input: strings.Replace(header, `"version":1`, `"version":2`, 1), Passing: false},
+		{Input: header + `{"t":5,"kind":"press"}` + "\n", Passing: false},
+		{Input: header + `{"t":5,"kind":"buttonup","button":"thumb"}` + "\n", Passing: false},
+		{Input: header + `{"t":5,"kind":"keyup"}` + "\n" + `{"t":4,"kind":"keyup"}` + "\n", Passing: false},
+		{Input: header + "not json\n" + `{"t":5,"kind":"keyup"}` + "\n", Passing: false},
+		{Input: "WIEL\x02", Passing: false},
+		{Input: "WIEL\x01\x00", Passing: false},
+		{Input: "WIEL\x01\x00\x00\x00\x02\x02\x00\x09", Passing: false},
+		{Input: "WIEL\x01\x00\x00\x00\x02\x02\x00\x03\x02\x04", Output: 1, Passing: true},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			l, err := winapi.DecodeEventLog(strings.NewReader(s.Input.(string)))
+			if !s.Passing {
+				if !errors.Is(err, winapi.ErrEventLogFormat) {
+					t.Errorf(test.ErrWantFGotF, winapi.ErrEventLogFormat, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf(test.ErrUnexpectedF, err)
+			}
+			if len(l.Events) != s.Output.(int) {
+				t.Errorf(test.ErrWantFGotF, s.Output, len(l.Events))
+			}
+		})
+	}
+}
+
+func TestEventLogCompile(t *testing.T) {
+	tName := "EventLogCompile"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	scenes := []test.Scene{
+		{
+			Input: winapi.PlayOptions{},
+			Output: []string{
+				"0s key down RCtrl",
+				"40ms key up RCtrl",
+				"40ms mouse move 0,65535 absolute",
+				"1.5s mouse move 65535,0 absolute, x2 down",
+				"1.6s mouse move 65535,0 absolute, x2 up",
+				"2s mouse move 32776,32798 absolute, wheel -240",
+				"2.000000001s mouse move 32776,32798 absolute, hwheel 120",
+			},
+		},
+		{
+			Input: winapi.PlayOptions{
+				Speed: 2,
+				Remap: func(x, y int32) (int32, int32) { return -x - 1, y },
+			},
+			Output: []string{
+				"0s key down RCtrl",
+				"20ms key up RCtrl",
+				"20ms mouse move 65535,65535 absolute",
+				"750ms mouse move 0,0 absolute, x2 down",
+				"800ms mouse move 0,0 absolute, x2 up",
+				"1s mouse move 32759,32798 absolute, wheel -240",
+				"1s mouse move 32759,32798 absolute, hwheel 120",
+			},
+		},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			q, err := eventLog().Compile(s.Input.(winapi.PlayOptions))
+			if err != nil {
+				t.Fatalf(test.ErrUnexpectedF, err)
+			}
+
+			var got []string
+			for _, b := range q.Plan() {
+				for _, in := range b.Inputs {
+					got = append(got, fmt.Sprintf("%v %v", b.At, in))
+				}
+			}
+			if !reflect.DeepEqual(got, s.Output) {
+				t.Errorf(test.ErrWantFGotF, s.Output, got)
+			}
+		})
+	}
+}
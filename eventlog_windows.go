@@ -0,0 +1,261 @@
+//go:build windows
+
+package winapi
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"golang.org/x/sys/windows"
+)
+
+// A Recorder records global keyboard and mouse input to an event log with
+// low-level hooks.
+type Recorder struct {
+	// IncludeInjected records injected events, such as those sent by
+	// SendInput, as well. By default they are left out, so that a [Player]
+	// replaying in the same session is not recorded.
+	IncludeInjected bool
+}
+
+// recorderBuffer is how many events a [Recorder] buffers between the hooks,
+// which must not wait, and the log.
+const recorderBuffer = 4096
+
+// ErrRecorderOverflow is returned when a [Recorder] receives events faster
+// than it can write them, and some are lost.
+var ErrRecorderOverflow = errors.New("winapi: recorder buffer overflow")
+
+// Record writes every keyboard and mouse event of the current desktop to w in
+// format, with times relative to the call to Record, until ctx is done. The
+// log header holds the bounds of the virtual screen, and each event is written
+// as soon as it is received, so a log cut short by a crash is still readable
+// by [DecodeEventLog].
+// It returns an error if the hooks cannot be installed, writing fails, or
+// [ErrRecorderOverflow] if events were lost, and nil once ctx is done.
+//
+// Experimental: Record has not been tested or used internally.
+func (r *Recorder) Record(ctx context.Context, w io.Writer, format EventLogFormat) error {
+	start := time.Now()
+	enc, err := NewEventLogEncoder(w, format, EventLogHeader{
+		Start:  start,
+		Left:   GetSystemMetrics(SM_XVIRTUALSCREEN),
+		Top:    GetSystemMetrics(SM_YVIRTUALSCREEN),
+		Width:  GetSystemMetrics(SM_CXVIRTUALSCREEN),
+		Height: GetSystemMetrics(SM_CYVIRTUALSCREEN),
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	// The hooks stamp each event and hand it over without blocking; this
+	// goroutine writes them in order of their stamps as they come.
+	var (
+		mu     sync.Mutex
+		last   time.Duration
+		closed bool
+		events = make(chan LogEvent, recorderBuffer)
+	)
+	push := func(ev LogEvent) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		// A handler that outlived its hook may still push once the hooks are
+		// gone.
+		if closed {
+			return
+		}
+
+		ev.At = max(time.Since(start), last)
+		last = ev.At
+		select {
+		case events <- ev:
+		default:
+			cancel(ErrRecorderOverflow)
+		}
+	}
+
+	var wg sync.WaitGroup
+	hook := func(run func(context.Context) error) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := run(ctx); err != nil {
+				cancel(err)
+			}
+		}()
+	}
+	hook(func(ctx context.Context) error {
+		return HookKeyboard(ctx, func(e KeyboardEvent) bool {
+			if e.Injected() && !r.IncludeInjected {
+				return false
+			}
+
+			ev := LogEvent{
+				Kind:     LogKeyDown,
+				Vk:       uint16(e.VkCode),
+				Scan:     uint16(e.ScanCode),
+				Extended: e.Flags&LLKHF_EXTENDED != 0,
+			}
+			if e.Flags&LLKHF_UP != 0 {
+				ev.Kind = LogKeyUp
+			}
+			push(ev)
+
+			return false
+		})
+	})
+	hook(func(ctx context.Context) error {
+		return HookMouse(ctx, func(e MouseEvent) bool {
+			if e.Injected() && !r.IncludeInjected {
+				return false
+			}
+
+			if ev, ok := mouseLogEvent(e); ok {
+				push(ev)
+			}
+
+			return false
+		})
+	})
+	go func() {
+		wg.Wait()
+		mu.Lock()
+		closed = true
+		close(events)
+		mu.Unlock()
+	}()
+
+	for ev := range events {
+		if err := enc.Encode(ev); err != nil {
+			cancel(err)
+			for range events {
+			}
+			return err
+		}
+	}
+
+	if err := context.Cause(ctx); err != nil && !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+
+	return nil
+}
+
+// mouseLogEvent returns the [LogEvent] of e, or false if e is not a move,
+// button or wheel event.
+func mouseLogEvent(e MouseEvent) (LogEvent, bool) {
+	ev := LogEvent{X: e.Pt.X, Y: e.Pt.Y}
+
+	switch e.Message {
+	case WM_MOUSEMOVE:
+		ev.Kind = LogMove
+	case WM_LBUTTONDOWN, WM_RBUTTONDOWN, WM_MBUTTONDOWN, WM_XBUTTONDOWN:
+		ev.Kind = LogButtonDown
+	case WM_LBUTTONUP, WM_RBUTTONUP, WM_MBUTTONUP, WM_XBUTTONUP:
+		ev.Kind = LogButtonUp
+	case WM_MOUSEWHEEL:
+		ev.Kind, ev.Delta = LogWheel, int32(int16(e.MouseData>>16))
+	case WM_MOUSEHWHEEL:
+		ev.Kind, ev.Delta = LogHWheel, int32(int16(e.MouseData>>16))
+	default:
+		return ev, false
+	}
+
+	switch e.Message {
+	case WM_RBUTTONDOWN, WM_RBUTTONUP:
+		ev.Button = MouseRight
+	case WM_MBUTTONDOWN, WM_MBUTTONUP:
+		ev.Button = MouseMiddle
+	case WM_XBUTTONDOWN, WM_XBUTTONUP:
+		ev.Button = MouseX1
+		if e.MouseData>>16 == uint32(XBUTTON2) {
+			ev.Button = MouseX2
+		}
+	}
+
+	return ev, true
+}
+
+// A Player replays an [EventLog] through SendInput. Cancel the context of
+// [Player.Play] to abort the replay.
+type Player struct {
+	q *InputQueue
+
+	mu   sync.Mutex
+	skip windows.Handle // set while playing
+}
+
+// NewPlayer returns a [Player] that replays l with opts.
+// It returns the error of [EventLog.Compile].
+//
+// Experimental: NewPlayer has not been tested or used internally.
+func NewPlayer(l *EventLog, opts PlayOptions) (*Player, error) {
+	q, err := l.Compile(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Player{q: q}, nil
+}
+
+// Duration returns how long a replay of p takes, without skips.
+//
+// Experimental: Duration has not been tested or used internally.
+func (p *Player) Duration() time.Duration {
+	return p.q.Duration()
+}
+
+// Play replays the events of p at their due time, measured from the call to
+// Play, until the last event is sent or ctx is done. Any key or button left
+// held when the replay stops early is released.
+// It returns ctx.Err() if ctx is done first, or the error of [SendInputN],
+// joined with the error of releasing the held keys and buttons, if any.
+//
+// Experimental: Play has not been tested or used internally.
+func (p *Player) Play(ctx context.Context) error {
+	skip, err := windows.CreateEvent(nil, 0, 0, nil)
+	if err != nil {
+		return err
+	}
+	defer windows.CloseHandle(skip)
+
+	p.mu.Lock()
+	p.skip = skip
+	p.mu.Unlock()
+	defer func() {
+		p.mu.Lock()
+		p.skip = 0
+		p.mu.Unlock()
+	}()
+
+	var ledger InputLedger
+	err = p.q.run(ctx, skip, &ledger)
+	if ups := ledger.Releases(); len(ups) > 0 {
+		if _, releaseErr := SendInputN(ups, 0); releaseErr != nil {
+			err = errors.Join(err, releaseErr)
+		}
+	}
+
+	return err
+}
+
+// Skip ends the wait for the next event of a replay in progress, so that it
+// is sent at once. The later events keep their spacing. Skip does nothing if
+// p is not playing.
+//
+// Experimental: Skip has not been tested or used internally.
+func (p *Player) Skip() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.skip != 0 {
+		windows.SetEvent(p.skip)
+	}
+}
@@ -0,0 +1,85 @@
+//go:build windows
+
+package winapi
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+var (
+	gdi32            = syscall.NewLazyDLL("gdi32.dll")
+	procDeleteObject = gdi32.NewProc("DeleteObject")
+	procGetDIBits    = gdi32.NewProc("GetDIBits")
+	procGetObjectW   = gdi32.NewProc("GetObjectW")
+)
+
+// DIB_RGB_COLORS specifies that the color table of a bitmap contains literal
+// RGB values.
+const DIB_RGB_COLORS = 0
+
+// DeleteObject deletes a logical pen, brush, font, bitmap, region or palette,
+// freeing all system resources associated with it.
+// It returns an error if the call fails.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/wingdi/nf-wingdi-deleteobject
+//
+// Experimental: DeleteObject has not been tested or used internally.
+func DeleteObject(obj Handle) error {
+	if r1, _, _ := procDeleteObject.Call(uintptr(obj)); r1 == 0 {
+		return syscall.EINVAL
+	}
+
+	return nil
+}
+
+// GetDIBits copies lines of the bitmap hbm, starting at start, into bits as a
+// device-independent bitmap in the format described by bmi. For formats with
+// 8 or fewer bits per pixel, bmi must be followed in memory by room for the
+// color table.
+// It returns 0 with an error if the call fails, or the number of lines copied
+// with no error on success.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/wingdi/nf-wingdi-getdibits
+//
+// Experimental: GetDIBits has not been tested or used internally.
+func GetDIBits(hdc, hbm Handle, start, lines uint32, bits []byte, bmi *BITMAPINFOHEADER, usage uint32) (int, error) {
+	var p unsafe.Pointer
+	if len(bits) > 0 {
+		p = unsafe.Pointer(&bits[0])
+	}
+
+	r1, _, _ := procGetDIBits.Call(
+		uintptr(hdc),
+		uintptr(hbm),
+		uintptr(start),
+		uintptr(lines),
+		uintptr(p),
+		uintptr(unsafe.Pointer(bmi)),
+		uintptr(usage),
+	)
+	if r1 == 0 {
+		return 0, syscall.EINVAL
+	}
+
+	return int(int32(r1)), nil
+}
+
+// GetObjectW retrieves information about the graphics object obj into buf,
+// which must point to a struct of size bytes, such as a [BITMAP].
+// It returns an error if the call fails.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/wingdi/nf-wingdi-getobjectw
+//
+// Experimental: GetObjectW has not been tested or used internally.
+func GetObjectW(obj Handle, size int32, buf unsafe.Pointer) error {
+	if r1, _, _ := procGetObjectW.Call(
+		uintptr(obj),
+		uintptr(size),
+		uintptr(buf),
+	); r1 == 0 {
+		return syscall.EINVAL
+	}
+
+	return nil
+}
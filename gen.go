@@ -0,0 +1,137 @@
+//go:build ignore
+
+// This program generates the built-in keyboard layouts in layouts/ from the
+// layouts of the Windows system it runs on. Run it with "go generate".
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"github.com/kamaranl/winapi"
+)
+
+// layouts are the layouts to generate, with the shift state of each column.
+var layouts = []struct {
+	tag    string
+	name   string
+	klid   winapi.KLID
+	shifts []winapi.ShiftState
+}{
+	{"en-US", "US", 0x00000409, []winapi.ShiftState{0, winapi.StateShift}},
+	{"en-GB", "United Kingdom", 0x00000809, altGr},
+	{"de-DE", "German", 0x00000407, altGr},
+	{"fr-FR", "French", 0x0000040C, altGr},
+	{"es-ES", "Spanish", 0x0000040A, altGr},
+	{"ja-JP", "Japanese", 0x00000411, []winapi.ShiftState{
+		0, winapi.StateShift, winapi.StateKana, winapi.StateKana | winapi.StateShift,
+	}},
+}
+
+var altGr = []winapi.ShiftState{0, winapi.StateShift, winapi.StateAltGr, winapi.StateAltGr | winapi.StateShift}
+
+const header = "# Regenerate with \"go generate\" on Windows; see gen.go.\n"
+
+func main() {
+	for _, l := range layouts {
+		if err := generate(l.tag, l.name, l.klid, l.shifts); err != nil {
+			log.Fatalf("%s: %v", l.tag, err)
+		}
+	}
+}
+
+// generate writes layouts/tag.txt from the layout klid, which is loaded
+// without activating it.
+func generate(tag, name string, klid winapi.KLID, shifts []winapi.ShiftState) error {
+	hkl, err := winapi.LoadKeyboardLayoutW(klid, winapi.KLF_NOTELLSHELL)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(filepath.Join("layouts", tag+".txt"))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := bufio.NewWriter(f)
+	fmt.Fprintf(w, "# %s keyboard layout, %s.\n%sklid %v\nshift", name, tag, header, klid)
+	for _, s := range shifts {
+		fmt.Fprintf(w, " %d", s)
+	}
+	fmt.Fprint(w, "\n\n")
+
+	for scan := uint32(0x01); scan < 0x80; scan++ {
+		vk, err := winapi.MapVirtualKeyExW(scan, winapi.MAPVK_VSC_TO_VK_EX, winapi.Handle(hkl))
+		if err != nil || vk == 0 {
+			continue
+		}
+
+		cols := make([]string, len(shifts))
+		typed := false
+		for i, s := range shifts {
+			cols[i] = "-"
+			if r, dead, ok := translate(vk, scan, s, hkl); ok {
+				cols[i], typed = column(r, dead), true
+			}
+		}
+		if typed {
+			fmt.Fprintf(w, "%-4s %02X  %s\n", fmt.Sprintf("%02X", scan), vk, strings.Join(cols, "  "))
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	return f.Close()
+}
+
+// translate returns the character the key vk types in the shift state s.
+func translate(vk, scan uint32, s winapi.ShiftState, hkl winapi.HKL) (rune, bool, bool) {
+	var state [256]byte
+	for _, m := range []struct {
+		state winapi.ShiftState
+		vks   []uint16
+	}{
+		{winapi.StateShift, []uint16{winapi.VK_SHIFT, winapi.VK_LSHIFT}},
+		{winapi.StateCtrl, []uint16{winapi.VK_CONTROL, winapi.VK_LCONTROL}},
+		{winapi.StateAlt, []uint16{winapi.VK_MENU, winapi.VK_LMENU}},
+	} {
+		if s&m.state != 0 {
+			for _, vk := range m.vks {
+				state[vk] = 0x80
+			}
+		}
+	}
+	if s&winapi.StateKana != 0 {
+		state[winapi.VK_KANA] = 0x01
+	}
+
+	// Flag 0x4 leaves the dead-key buffer of the thread unchanged.
+	var buf [8]uint16
+	n := winapi.ToUnicodeEx(vk, scan, &state, buf[:], 0x4, hkl)
+	if n == 0 || n > 1 || buf[0] < ' ' || buf[0] == 0x7F {
+		return 0, false, false
+	}
+
+	return rune(buf[0]), n < 0, true
+}
+
+// column returns the column of a layout file for the character r.
+func column(r rune, dead bool) string {
+	s := string(r)
+	if r == '-' || r == '#' || !unicode.IsGraphic(r) || unicode.IsSpace(r) {
+		s = fmt.Sprintf("U+%04X", r)
+	}
+	if dead {
+		s += "*"
+	}
+
+	return s
+}
@@ -0,0 +1,25 @@
+package winapi
+
+import "fmt"
+
+// A GUID is a 128-bit globally unique identifier laid out exactly as the
+// Windows GUID struct, so it can be converted to and from windows.GUID.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/guiddef/ns-guiddef-guid
+type GUID struct {
+	Data1 uint32 // (unsigned long)
+	Data2 uint16 // (unsigned short)
+	Data3 uint16 // (unsigned short)
+	Data4 [8]byte
+}
+
+// String returns g in the registry format, e.g.
+// {B4BFCC3A-DB2C-424C-B029-7FE99A87C641}.
+func (g GUID) String() string {
+	return fmt.Sprintf(
+		"{%08X-%04X-%04X-%02X%02X-%02X%02X%02X%02X%02X%02X}",
+		g.Data1, g.Data2, g.Data3,
+		g.Data4[0], g.Data4[1],
+		g.Data4[2], g.Data4[3], g.Data4[4], g.Data4[5], g.Data4[6], g.Data4[7],
+	)
+}
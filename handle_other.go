@@ -0,0 +1,11 @@
+//go:build !windows
+
+package winapi
+
+// Handle and HWND stand in for the golang.org/x/sys/windows types of the same
+// names on other platforms, so that the portable structs that contain them,
+// such as [MSG], keep their Windows layout and can be tested anywhere.
+
+type Handle uintptr
+
+type HWND uintptr
@@ -0,0 +1,273 @@
+package winapi
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// An HKL is an input locale identifier, the handle of a keyboard layout loaded
+// for an input language. Its low word is the [LANGID] of the input language and
+// its high word identifies the physical layout. Pass it to MapVirtualKeyExW or
+// VkKeyScanExW as Handle(hkl).
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/intl/language-identifiers
+type HKL uintptr
+
+// [HKL] constants, accepted by ActivateKeyboardLayout.
+const (
+	HKL_PREV HKL = 0
+	HKL_NEXT HKL = 1
+)
+
+// LangID returns the input language of h.
+func (h HKL) LangID() LANGID {
+	return LANGID(h & 0xFFFF)
+}
+
+// Device returns the identifier of the physical layout of h, which equals its
+// language identifier for the default layout of the language.
+func (h HKL) Device() uint16 {
+	return uint16(h >> 16)
+}
+
+// String returns h as 8 hexadecimal digits, such as "04090409".
+func (h HKL) String() string {
+	return fmt.Sprintf("%08X", uint32(h))
+}
+
+// A LANGID is a language identifier: a primary language in its low 10 bits and
+// a sublanguage, usually a region, in its high 6 bits.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/intl/language-identifiers
+type LANGID uint16
+
+// Primary returns the primary language of id.
+func (id LANGID) Primary() uint16 {
+	return uint16(id) & 0x3FF
+}
+
+// Sub returns the sublanguage of id.
+func (id LANGID) Sub() uint16 {
+	return uint16(id) >> 10
+}
+
+// Tag returns the BCP 47 language tag of id, such as "en-US", or an empty
+// string if id is not in the table of the package.
+func (id LANGID) Tag() string {
+	for _, l := range langTags {
+		if l.id == id {
+			return l.tag
+		}
+	}
+
+	return ""
+}
+
+// String returns id as 4 hexadecimal digits, such as "0409".
+func (id LANGID) String() string {
+	return fmt.Sprintf("%04X", uint16(id))
+}
+
+// LangIDForTag returns the language identifier of the BCP 47 language tag,
+// which is case-insensitive and may use underscores. A tag without a region,
+// such as "fr", returns the language in its main region.
+// It returns false if tag is not in the table of the package.
+func LangIDForTag(tag string) (LANGID, bool) {
+	tag = strings.ReplaceAll(tag, "_", "-")
+	for _, l := range langTags {
+		if strings.EqualFold(l.tag, tag) {
+			return l.id, true
+		}
+	}
+
+	// The first entry of each language is its main region.
+	for _, l := range langTags {
+		if lang, _, _ := strings.Cut(l.tag, "-"); strings.EqualFold(lang, tag) {
+			return l.id, true
+		}
+	}
+
+	return 0, false
+}
+
+// A KLID is a keyboard layout identifier, the name of a layout as stored in
+// the registry and passed to LoadKeyboardLayoutW. Its low word is the
+// [LANGID] of the layout and its high word selects a variant, such as 0x0001
+// for US-Dvorak (00010409), or an IME or custom layout (0xA000 and up).
+//
+// See: https://learn.microsoft.com/en-us/windows-hardware/manufacture/desktop/windows-language-pack-default-values
+type KLID uint32
+
+// ParseKLID parses a keyboard layout identifier of 8 hexadecimal digits, such
+// as "00000409".
+// It returns an error if s is not 8 hexadecimal digits.
+func ParseKLID(s string) (KLID, error) {
+	if len(s) != 8 {
+		return 0, fmt.Errorf("winapi: invalid KLID %q: want 8 hexadecimal digits", s)
+	}
+
+	v, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("winapi: invalid KLID %q: want 8 hexadecimal digits", s)
+	}
+
+	return KLID(v), nil
+}
+
+// KLIDForTag returns the identifier of the default keyboard layout of the BCP
+// 47 language tag, as found by [LangIDForTag].
+// It returns false if tag is not in the table of the package.
+func KLIDForTag(tag string) (KLID, bool) {
+	id, ok := LangIDForTag(tag)
+
+	return KLID(id), ok
+}
+
+// LangID returns the language of k.
+func (k KLID) LangID() LANGID {
+	return LANGID(k & 0xFFFF)
+}
+
+// Variant returns the variant of k, or 0 for the default layout of its
+// language.
+func (k KLID) Variant() uint16 {
+	return uint16(k >> 16)
+}
+
+// Tag returns the BCP 47 language tag of the language of k.
+func (k KLID) Tag() string {
+	return k.LangID().Tag()
+}
+
+// String returns k as 8 hexadecimal digits, such as "00000409".
+func (k KLID) String() string {
+	return fmt.Sprintf("%08X", uint32(k))
+}
+
+// langTags maps language identifiers to BCP 47 language tags. The first entry
+// of a language is its main region, and the first entry of a tag is the
+// identifier it maps back to.
+var langTags = []struct {
+	id  LANGID
+	tag string
+}{
+	{0x0436, "af-ZA"},
+	{0x045E, "am-ET"},
+	{0x0401, "ar-SA"},
+	{0x0C01, "ar-EG"},
+	{0x042C, "az-Latn-AZ"},
+	{0x082C, "az-Cyrl-AZ"},
+	{0x0423, "be-BY"},
+	{0x0402, "bg-BG"},
+	{0x0445, "bn-IN"},
+	{0x0451, "bo-CN"},
+	{0x141A, "bs-Latn-BA"},
+	{0x0403, "ca-ES"},
+	{0x0405, "cs-CZ"},
+	{0x0452, "cy-GB"},
+	{0x0406, "da-DK"},
+	{0x0407, "de-DE"},
+	{0x0C07, "de-AT"},
+	{0x0807, "de-CH"},
+	{0x1407, "de-LI"},
+	{0x1007, "de-LU"},
+	{0x0408, "el-GR"},
+	{0x0409, "en-US"},
+	{0x0C09, "en-AU"},
+	{0x1009, "en-CA"},
+	{0x0809, "en-GB"},
+	{0x1809, "en-IE"},
+	{0x4009, "en-IN"},
+	{0x2009, "en-JM"},
+	{0x1409, "en-NZ"},
+	{0x3409, "en-PH"},
+	{0x4809, "en-SG"},
+	{0x1C09, "en-ZA"},
+	{0x0C0A, "es-ES"},
+	{0x040A, "es-ES"}, // traditional sort
+	{0x2C0A, "es-AR"},
+	{0x340A, "es-CL"},
+	{0x240A, "es-CO"},
+	{0x080A, "es-MX"},
+	{0x540A, "es-US"},
+	{0x0425, "et-EE"},
+	{0x042D, "eu-ES"},
+	{0x0429, "fa-IR"},
+	{0x040B, "fi-FI"},
+	{0x0438, "fo-FO"},
+	{0x040C, "fr-FR"},
+	{0x080C, "fr-BE"},
+	{0x0C0C, "fr-CA"},
+	{0x100C, "fr-CH"},
+	{0x140C, "fr-LU"},
+	{0x0462, "fy-NL"},
+	{0x0456, "gl-ES"},
+	{0x0447, "gu-IN"},
+	{0x040D, "he-IL"},
+	{0x0439, "hi-IN"},
+	{0x041A, "hr-HR"},
+	{0x040E, "hu-HU"},
+	{0x042B, "hy-AM"},
+	{0x0421, "id-ID"},
+	{0x040F, "is-IS"},
+	{0x0410, "it-IT"},
+	{0x0810, "it-CH"},
+	{0x0411, "ja-JP"},
+	{0x0437, "ka-GE"},
+	{0x043F, "kk-KZ"},
+	{0x0453, "km-KH"},
+	{0x044B, "kn-IN"},
+	{0x0412, "ko-KR"},
+	{0x0440, "ky-KG"},
+	{0x046E, "lb-LU"},
+	{0x0454, "lo-LA"},
+	{0x0427, "lt-LT"},
+	{0x0426, "lv-LV"},
+	{0x0481, "mi-NZ"},
+	{0x042F, "mk-MK"},
+	{0x044C, "ml-IN"},
+	{0x0450, "mn-MN"},
+	{0x044E, "mr-IN"},
+	{0x043E, "ms-MY"},
+	{0x043A, "mt-MT"},
+	{0x0414, "nb-NO"},
+	{0x0461, "ne-NP"},
+	{0x0413, "nl-NL"},
+	{0x0813, "nl-BE"},
+	{0x0814, "nn-NO"},
+	{0x0446, "pa-IN"},
+	{0x0415, "pl-PL"},
+	{0x0463, "ps-AF"},
+	{0x0416, "pt-BR"},
+	{0x0816, "pt-PT"},
+	{0x0417, "rm-CH"},
+	{0x0418, "ro-RO"},
+	{0x0419, "ru-RU"},
+	{0x045B, "si-LK"},
+	{0x041B, "sk-SK"},
+	{0x0424, "sl-SI"},
+	{0x041C, "sq-AL"},
+	{0x241A, "sr-Latn-RS"},
+	{0x281A, "sr-Cyrl-RS"},
+	{0x041D, "sv-SE"},
+	{0x081D, "sv-FI"},
+	{0x0441, "sw-KE"},
+	{0x045A, "syr-SY"},
+	{0x0449, "ta-IN"},
+	{0x044A, "te-IN"},
+	{0x0428, "tg-Cyrl-TJ"},
+	{0x041E, "th-TH"},
+	{0x0442, "tk-TM"},
+	{0x041F, "tr-TR"},
+	{0x0444, "tt-RU"},
+	{0x0422, "uk-UA"},
+	{0x0420, "ur-PK"},
+	{0x0443, "uz-Latn-UZ"},
+	{0x0843, "uz-Cyrl-UZ"},
+	{0x042A, "vi-VN"},
+	{0x0804, "zh-CN"},
+	{0x0C04, "zh-HK"},
+	{0x1004, "zh-SG"},
+	{0x0404, "zh-TW"},
+}
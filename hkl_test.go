@@ -0,0 +1,138 @@
+package winapi_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kamaranl/gotools/test"
+	"github.com/kamaranl/winapi"
+)
+
+func TestLangIDForTag(t *testing.T) {
+	tName := "LangIDForTag"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	scenes := []test.Scene{
+		{Input: "en-US", Output: winapi.LANGID(0x0409), Passing: true},
+		{Input: "de_ch", Output: winapi.LANGID(0x0807), Passing: true},
+		{Input: "es-ES", Output: winapi.LANGID(0x0C0A), Passing: true},
+		{Input: "fr", Output: winapi.LANGID(0x040C), Passing: true},
+		{Input: "sr", Output: winapi.LANGID(0x241A), Passing: true},
+		{Input: "SR-CYRL-RS", Output: winapi.LANGID(0x281A), Passing: true},
+		{Input: "xx-YY", Passing: false},
+		{Input: "", Passing: false},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			got, ok := winapi.LangIDForTag(s.Input.(string))
+			if ok != s.Passing {
+				t.Fatalf(test.ErrWantFGotF, s.Passing, ok)
+			}
+			if ok && got != s.Output.(winapi.LANGID) {
+				t.Errorf(test.ErrWantFGotF, s.Output, got)
+			}
+		})
+	}
+}
+
+func TestLangIDTag(t *testing.T) {
+	tName := "LangIDTag"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	scenes := []test.Scene{
+		{Input: winapi.LANGID(0x0409), Output: "en-US"},
+		{Input: winapi.LANGID(0x040A), Output: "es-ES"},
+		{Input: winapi.LANGID(0x0804), Output: "zh-CN"},
+		{Input: winapi.LANGID(0x0000), Output: ""},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			id := s.Input.(winapi.LANGID)
+			if got := id.Tag(); got != s.Output {
+				t.Errorf(test.ErrWantFGotF, s.Output, got)
+			}
+
+			// Every tag maps back to an identifier with the same tag.
+			if tag := id.Tag(); tag != "" {
+				back, ok := winapi.LangIDForTag(tag)
+				if !ok || back.Tag() != tag {
+					t.Errorf(test.ErrWantFGotF, tag, back.Tag())
+				}
+			}
+		})
+	}
+}
+
+func TestParseKLID(t *testing.T) {
+	tName := "ParseKLID"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	type klid struct {
+		id      winapi.KLID
+		lang    winapi.LANGID
+		variant uint16
+		tag     string
+	}
+
+	scenes := []test.Scene{
+		{Input: "00000409", Output: klid{0x00000409, 0x0409, 0, "en-US"}, Passing: true},
+		{Input: "00010409", Output: klid{0x00010409, 0x0409, 1, "en-US"}, Passing: true},
+		{Input: "0000040c", Output: klid{0x0000040C, 0x040C, 0, "fr-FR"}, Passing: true},
+		{Input: "E0010411", Output: klid{0xE0010411, 0x0411, 0xE001, "ja-JP"}, Passing: true},
+		{Input: "409", Passing: false},
+		{Input: "0000040G", Passing: false},
+		{Input: "+0000409", Passing: false},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			k, err := winapi.ParseKLID(s.Input.(string))
+			if !s.Passing {
+				if err == nil {
+					t.Errorf(test.ErrWantFGotF, "error", k)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf(test.ErrUnexpectedF, err)
+			}
+			if got := (klid{k, k.LangID(), k.Variant(), k.Tag()}); got != s.Output.(klid) {
+				t.Errorf(test.ErrWantFGotF, s.Output, got)
+			}
+			if back, _ := winapi.ParseKLID(k.String()); back != k {
+				t.Errorf(test.ErrWantFGotF, k, back)
+			}
+		})
+	}
+}
+
+func TestHKL(t *testing.T) {
+	tName := "HKL"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	scenes := []test.Scene{
+		{Input: winapi.HKL(0x04090409), Output: "04090409 0409 0409"},
+		{Input: winapi.HKL(0xF0020409), Output: "F0020409 0409 F002"},
+		{Input: winapi.HKL(0x0000000004110411), Output: "04110411 0411 0411"},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			h := s.Input.(winapi.HKL)
+			if got := fmt.Sprintf("%v %v %04X", h, h.LangID(), h.Device()); got != s.Output {
+				t.Errorf(test.ErrWantFGotF, s.Output, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+//go:build windows
+
+package winapi
+
+import (
+	"golang.org/x/sys/windows"
+)
+
+// LayoutForWindow retrieves the keyboard layout used by the thread that owns
+// hwnd, or by the foreground window if hwnd is 0, so that text can be
+// translated with the layout of the window that receives it.
+// It returns an error if hwnd is not a window, or if there is no foreground
+// window.
+//
+// Experimental: LayoutForWindow has not been tested or used internally.
+func LayoutForWindow(hwnd HWND) (HKL, error) {
+	if hwnd == 0 {
+		if hwnd = windows.GetForegroundWindow(); hwnd == 0 {
+			return 0, windows.ERROR_INVALID_WINDOW_HANDLE
+		}
+	}
+
+	tid, err := windows.GetWindowThreadProcessId(hwnd, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	return GetKeyboardLayout(tid), nil
+}
+
+// RequestLayout asks the window hwnd, or the foreground window if hwnd is 0,
+// to switch its input language to hkl by posting WM_INPUTLANGCHANGEREQUEST,
+// as the language bar does. The window may refuse, and the switch happens
+// asynchronously; check it with [LayoutForWindow].
+// It returns an error if the message cannot be posted.
+//
+// Experimental: RequestLayout has not been tested or used internally.
+func RequestLayout(hwnd HWND, hkl HKL) error {
+	if hwnd == 0 {
+		if hwnd = windows.GetForegroundWindow(); hwnd == 0 {
+			return windows.ERROR_INVALID_WINDOW_HANDLE
+		}
+	}
+
+	return PostMessageW(hwnd, WM_INPUTLANGCHANGEREQUEST, 0, uintptr(hkl))
+}
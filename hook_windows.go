@@ -0,0 +1,228 @@
+//go:build windows
+
+package winapi
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+	"syscall"
+	"time"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// HookTimeout is how long a [KeyboardHandler] or [MouseHandler] may run before
+// the event is passed on as if the handler had returned false. Windows removes
+// low-level hooks that repeatedly exceed its own LowLevelHooksTimeout (between
+// 300 and 1000 ms, depending on the version), so HookTimeout should stay well
+// below that.
+var HookTimeout = 200 * time.Millisecond
+
+// A KeyboardEvent is a low-level keyboard input event delivered to a
+// [KeyboardHandler].
+type KeyboardEvent struct {
+	// Message is WM_KEYDOWN, WM_KEYUP, WM_SYSKEYDOWN or WM_SYSKEYUP.
+	Message MsgId
+
+	KBDLLHOOKSTRUCT
+}
+
+// Injected reports whether the event was injected, e.g. by SendInput, rather
+// than generated by a keyboard.
+func (e KeyboardEvent) Injected() bool {
+	return e.Flags&LLKHF_INJECTED != 0
+}
+
+// A MouseEvent is a low-level mouse input event delivered to a [MouseHandler].
+type MouseEvent struct {
+	// Message is one of the WM_MOUSEMOVE, WM_*BUTTON*, WM_MOUSEWHEEL or
+	// WM_MOUSEHWHEEL messages.
+	Message MsgId
+
+	MSLLHOOKSTRUCT
+}
+
+// Injected reports whether the event was injected, e.g. by SendInput, rather
+// than generated by a mouse.
+func (e MouseEvent) Injected() bool {
+	return e.Flags&LLMHF_INJECTED != 0
+}
+
+// A KeyboardHandler handles a low-level keyboard event. It returns true to
+// swallow the event, so that no other hook or application receives it.
+type KeyboardHandler func(KeyboardEvent) bool
+
+// A MouseHandler handles a low-level mouse event. It returns true to swallow
+// the event, so that no other hook or application receives it.
+type MouseHandler func(MouseEvent) bool
+
+// llHook is a low-level hook installed on a locked thread.
+type llHook struct {
+	handle Handle
+	filter func(wParam, lParam uintptr) bool
+}
+
+var (
+	// llHooks maps the id of each hook thread to its hook, since a callback
+	// created by syscall.NewCallback is never freed and is shared by every
+	// hook of its type.
+	llHooksMu sync.Mutex
+	llHooks   = map[uint32]*llHook{}
+
+	llHookProc = syscall.NewCallback(func(code, wParam, lParam uintptr) uintptr {
+		llHooksMu.Lock()
+		h := llHooks[windows.GetCurrentThreadId()]
+		llHooksMu.Unlock()
+
+		if h == nil {
+			return CallNextHookEx(0, int32(code), wParam, lParam)
+		}
+		if int32(code) == HC_ACTION && h.filter(wParam, lParam) {
+			return 1
+		}
+
+		return CallNextHookEx(h.handle, int32(code), wParam, lParam)
+	})
+)
+
+// HookKeyboard installs a low-level keyboard hook (WH_KEYBOARD_LL) that calls
+// handler for every keyboard input event in the current desktop, and blocks
+// until ctx is done. The hook runs on a dedicated thread with its own message
+// loop, and is removed before HookKeyboard returns.
+//
+// handler is called from a separate goroutine. If it has not returned within
+// [HookTimeout], the event is passed on and its result is ignored, so handler
+// may still be running when the next event arrives and must be safe for
+// concurrent use.
+// It returns an error if the hook cannot be installed or removed, or if its
+// message loop fails before ctx is done.
+//
+// Experimental: HookKeyboard has not been tested or used internally.
+func HookKeyboard(ctx context.Context, handler KeyboardHandler) error {
+	return runLLHook(ctx, WH_KEYBOARD_LL, func(wParam, lParam uintptr) bool {
+		ev := KeyboardEvent{
+			Message:         MsgId(wParam),
+			KBDLLHOOKSTRUCT: *(*KBDLLHOOKSTRUCT)(unsafe.Pointer(lParam)),
+		}
+
+		return callWithTimeout(func() bool { return handler(ev) })
+	})
+}
+
+// HookMouse installs a low-level mouse hook (WH_MOUSE_LL) that calls handler
+// for every mouse input event in the current desktop, and blocks until ctx is
+// done. It behaves like [HookKeyboard] otherwise.
+// It returns an error if the hook cannot be installed or removed, or if its
+// message loop fails before ctx is done.
+//
+// Experimental: HookMouse has not been tested or used internally.
+func HookMouse(ctx context.Context, handler MouseHandler) error {
+	return runLLHook(ctx, WH_MOUSE_LL, func(wParam, lParam uintptr) bool {
+		ev := MouseEvent{
+			Message:        MsgId(wParam),
+			MSLLHOOKSTRUCT: *(*MSLLHOOKSTRUCT)(unsafe.Pointer(lParam)),
+		}
+
+		return callWithTimeout(func() bool { return handler(ev) })
+	})
+}
+
+// callWithTimeout calls fn in a new goroutine.
+// It returns the result of fn, or false if fn does not return within
+// [HookTimeout].
+func callWithTimeout(fn func() bool) bool {
+	done := make(chan bool, 1)
+	go func() { done <- fn() }()
+
+	timer := time.NewTimer(HookTimeout)
+	defer timer.Stop()
+
+	select {
+	case swallow := <-done:
+		return swallow
+	case <-timer.C:
+		return false
+	}
+}
+
+// runLLHook installs a low-level hook of type id on a new locked thread, pumps
+// its messages until ctx is done or the message loop fails, then removes it.
+func runLLHook(ctx context.Context, id WH, filter func(wParam, lParam uintptr) bool) error {
+	type started struct {
+		tid uint32
+		err error
+	}
+
+	ready := make(chan started, 1)
+	done := make(chan error, 1)
+
+	go func() {
+		runtime.LockOSThread()
+		defer runtime.UnlockOSThread()
+
+		// Create the message queue, so that WM_QUIT can be posted to it as
+		// soon as the thread id is known.
+		var msg MSG
+		PeekMessageW(&msg, 0, WM_USER, WM_USER, PM_NOREMOVE)
+
+		tid := windows.GetCurrentThreadId()
+		h := &llHook{filter: filter}
+
+		var mod windows.Handle
+		if err := windows.GetModuleHandleEx(0, nil, &mod); err != nil {
+			ready <- started{err: err}
+			return
+		}
+
+		llHooksMu.Lock()
+		llHooks[tid] = h
+		llHooksMu.Unlock()
+		defer func() {
+			llHooksMu.Lock()
+			delete(llHooks, tid)
+			llHooksMu.Unlock()
+		}()
+
+		handle, err := SetWindowsHookExW(id, llHookProc, mod, 0)
+		if err != nil {
+			ready <- started{err: err}
+			return
+		}
+
+		// The hook procedure only runs on this thread, inside getMessage.
+		h.handle = handle
+
+		ready <- started{tid: tid}
+
+		for {
+			var r1 uintptr
+			if r1, err = getMessage(&msg, 0, 0, 0); r1 == 0 || err != nil {
+				break
+			}
+		}
+
+		done <- errors.Join(err, UnhookWindowsHookEx(handle))
+	}()
+
+	s := <-ready
+	if s.err != nil {
+		return s.err
+	}
+
+	// The message loop only ends early if GetMessage fails or another thread
+	// posts WM_QUIT to it, in which case the hook is already removed.
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+	}
+
+	if err := PostThreadMessageW(s.tid, WM_QUIT, 0, 0); err != nil {
+		return err
+	}
+
+	return <-done
+}
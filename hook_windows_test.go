@@ -0,0 +1,70 @@
+//go:build windows
+
+package winapi_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kamaranl/gotools/test"
+	"github.com/kamaranl/winapi"
+)
+
+// TestRealHookKeyboard injects F24 key presses and swallows them in a
+// low-level keyboard hook, so that no application receives them.
+func TestRealHookKeyboard(t *testing.T) {
+	tName := "HookKeyboard"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	const vkF24 = 0x87
+	down := winapi.NewKeybdInput(winapi.KEYBDINPUT{Vk: vkF24, ExtraInfo: 0x5EED})
+	up := down
+	up.Ki.Flags |= winapi.KEYEVENTF_KEYUP
+
+	scenes := []test.Scene{
+		{Input: []winapi.INPUT_Ki{down, up}, Output: 2},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			events := make(chan winapi.KeyboardEvent, 8)
+			errc := make(chan error, 1)
+			go func() {
+				errc <- winapi.HookKeyboard(ctx, func(e winapi.KeyboardEvent) bool {
+					if e.VkCode != vkF24 {
+						return false
+					}
+
+					events <- e
+					return true
+				})
+			}()
+
+			time.Sleep(200 * time.Millisecond)
+			if err := winapi.SendInput(s.Input.([]winapi.INPUT_Ki)); err != nil {
+				t.Fatalf(test.ErrUnexpectedF, err)
+			}
+
+			for n := 0; n < s.Output.(int); n++ {
+				select {
+				case e := <-events:
+					if !e.Injected() || e.ExtraInfo != 0x5EED {
+						t.Errorf(test.ErrWantFGotF, "injected event", e)
+					}
+				case <-time.After(2 * time.Second):
+					t.Errorf(test.ErrWantFGotF, s.Output, n)
+				}
+			}
+
+			cancel()
+			if err := <-errc; err != nil {
+				t.Errorf(test.ErrWantFGotF, nil, err)
+			}
+		})
+	}
+}
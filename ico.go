@@ -0,0 +1,340 @@
+package winapi
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+)
+
+// ErrIconFormat is returned when data is not a valid icon (.ico) file or
+// device-independent bitmap.
+var ErrIconFormat = errors.New("winapi: invalid icon")
+
+// pngMagic is the signature at the start of PNG-compressed icon images.
+var pngMagic = []byte("\x89PNG\r\n\x1a\n")
+
+// DecodeICO decodes every image of the icon (.ico) file read from r. Images
+// may be stored as PNG or as device-independent bitmaps of 1, 4, 8, 24 or 32
+// bits per pixel, in which case the alpha channel, or the AND mask if the
+// bitmap has no alpha channel, is used for transparency.
+// It returns an error wrapping [ErrIconFormat] if the data is malformed.
+func DecodeICO(r io.Reader) ([]image.Image, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) < 6 || binary.LittleEndian.Uint16(data[0:]) != 0 || binary.LittleEndian.Uint16(data[2:]) != 1 {
+		return nil, fmt.Errorf("%w: bad header", ErrIconFormat)
+	}
+
+	count := int(binary.LittleEndian.Uint16(data[4:]))
+	if len(data) < 6+16*count {
+		return nil, fmt.Errorf("%w: truncated directory", ErrIconFormat)
+	}
+
+	imgs := make([]image.Image, 0, count)
+	for i := 0; i < count; i++ {
+		entry := data[6+16*i:]
+		size := int(binary.LittleEndian.Uint32(entry[8:]))
+		off := int(binary.LittleEndian.Uint32(entry[12:]))
+		if off < 0 || size < 0 || off > len(data) || size > len(data)-off {
+			return nil, fmt.Errorf("%w: image %d out of bounds", ErrIconFormat, i)
+		}
+
+		res := data[off : off+size]
+		if bytes.HasPrefix(res, pngMagic) {
+			img, err := png.Decode(bytes.NewReader(res))
+			if err != nil {
+				return nil, fmt.Errorf("%w: image %d: %v", ErrIconFormat, i, err)
+			}
+
+			imgs = append(imgs, img)
+			continue
+		}
+
+		img, err := decodeDIB(res, true)
+		if err != nil {
+			return nil, fmt.Errorf("image %d: %w", i, err)
+		}
+
+		imgs = append(imgs, img)
+	}
+
+	return imgs, nil
+}
+
+// EncodeICO writes imgs to w as an icon (.ico) file. Images smaller than
+// 256x256 are stored as 32-bit bitmaps with an alpha channel and a matching
+// AND mask, which every version of Windows can read; 256x256 images are
+// stored as PNG.
+// It returns an error if imgs is empty or an image is larger than 256x256.
+func EncodeICO(w io.Writer, imgs ...image.Image) error {
+	if len(imgs) == 0 || len(imgs) > 0xFFFF {
+		return fmt.Errorf("%w: bad image count %d", ErrIconFormat, len(imgs))
+	}
+
+	res := make([][]byte, len(imgs))
+	for i, img := range imgs {
+		b := img.Bounds()
+		if b.Dx() < 1 || b.Dy() < 1 || b.Dx() > 256 || b.Dy() > 256 {
+			return fmt.Errorf("%w: image %d is %dx%d", ErrIconFormat, i, b.Dx(), b.Dy())
+		}
+
+		if b.Dx() == 256 || b.Dy() == 256 {
+			var buf bytes.Buffer
+			if err := png.Encode(&buf, img); err != nil {
+				return err
+			}
+
+			res[i] = buf.Bytes()
+			continue
+		}
+
+		res[i] = encodeDIB(img, true)
+	}
+
+	hdr := make([]byte, 6+16*len(imgs))
+	binary.LittleEndian.PutUint16(hdr[2:], 1)
+	binary.LittleEndian.PutUint16(hdr[4:], uint16(len(imgs)))
+	off := len(hdr)
+	for i, img := range imgs {
+		b := img.Bounds()
+		entry := hdr[6+16*i:]
+		entry[0] = byte(b.Dx()) // 256 wraps to 0, as the format requires
+		entry[1] = byte(b.Dy())
+		binary.LittleEndian.PutUint16(entry[4:], 1)
+		binary.LittleEndian.PutUint16(entry[6:], 32)
+		binary.LittleEndian.PutUint32(entry[8:], uint32(len(res[i])))
+		binary.LittleEndian.PutUint32(entry[12:], uint32(off))
+		off += len(res[i])
+	}
+
+	if _, err := w.Write(hdr); err != nil {
+		return err
+	}
+	for _, r := range res {
+		if _, err := w.Write(r); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DecodeDIB decodes a packed device-independent bitmap: a BITMAPINFOHEADER
+// followed by an optional color table and the pixel data, as found in the
+// clipboard's CF_DIB format. A 32-bit bitmap whose alpha channel is entirely
+// zero is treated as opaque.
+// It returns an error wrapping [ErrIconFormat] if the data is malformed.
+func DecodeDIB(data []byte) (image.Image, error) {
+	return decodeDIB(data, false)
+}
+
+// decodeDIB decodes a packed DIB. If icon is true, the header height covers
+// both the XOR bitmap and the AND mask that follows it.
+func decodeDIB(data []byte, icon bool) (image.Image, error) {
+	if len(data) < 40 {
+		return nil, fmt.Errorf("%w: truncated bitmap header", ErrIconFormat)
+	}
+
+	hdrSize := int(binary.LittleEndian.Uint32(data[0:]))
+	width := int(int32(binary.LittleEndian.Uint32(data[4:])))
+	height := int(int32(binary.LittleEndian.Uint32(data[8:])))
+	bpp := int(binary.LittleEndian.Uint16(data[14:]))
+	compression := binary.LittleEndian.Uint32(data[16:])
+	colorsUsed := int(binary.LittleEndian.Uint32(data[32:]))
+
+	topDown := height < 0
+	if topDown {
+		height = -height
+	}
+	if icon {
+		height /= 2
+	}
+
+	const biRGB, biBitfields = 0, 3
+	if hdrSize < 40 || hdrSize > len(data) || width <= 0 || height <= 0 || width > 1<<14 || height > 1<<14 {
+		return nil, fmt.Errorf("%w: bad bitmap dimensions", ErrIconFormat)
+	}
+	if compression != biRGB && !(compression == biBitfields && bpp == 32) {
+		return nil, fmt.Errorf("%w: unsupported compression %d", ErrIconFormat, compression)
+	}
+
+	var palette []color.NRGBA
+	switch bpp {
+	case 1, 4, 8:
+		n := colorsUsed
+		if n == 0 || n > 1<<bpp {
+			n = 1 << bpp
+		}
+		if len(data) < hdrSize+4*n {
+			return nil, fmt.Errorf("%w: truncated color table", ErrIconFormat)
+		}
+
+		palette = make([]color.NRGBA, n)
+		for i := range palette {
+			q := data[hdrSize+4*i:]
+			palette[i] = color.NRGBA{R: q[2], G: q[1], B: q[0], A: 0xFF}
+		}
+	case 24, 32:
+	default:
+		return nil, fmt.Errorf("%w: unsupported bit depth %d", ErrIconFormat, bpp)
+	}
+
+	pixOff := hdrSize + 4*len(palette)
+	if compression == biBitfields && hdrSize == 40 {
+		pixOff += 12 // color masks following a BITMAPINFOHEADER
+	}
+
+	stride := (width*bpp + 31) / 32 * 4
+	maskStride := (width + 31) / 32 * 4
+	need := pixOff + stride*height
+	if icon {
+		need += maskStride * height
+	}
+	if len(data) < need {
+		return nil, fmt.Errorf("%w: truncated pixel data", ErrIconFormat)
+	}
+
+	pix := data[pixOff : pixOff+stride*height]
+	var mask []byte
+	if icon {
+		mask = data[pixOff+stride*height : need]
+	}
+
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	hasAlpha := false
+	for y := 0; y < height; y++ {
+		row := y
+		if !topDown {
+			row = height - 1 - y
+		}
+
+		src := pix[row*stride:]
+		for x := 0; x < width; x++ {
+			var c color.NRGBA
+			switch bpp {
+			case 1:
+				c = palette[int(src[x/8]>>(7-x%8)&0x1)%len(palette)]
+			case 4:
+				c = palette[int(src[x/2]>>(4*(1-x%2))&0xF)%len(palette)]
+			case 8:
+				c = palette[int(src[x])%len(palette)]
+			case 24:
+				c = color.NRGBA{R: src[3*x+2], G: src[3*x+1], B: src[3*x], A: 0xFF}
+			case 32:
+				c = color.NRGBA{R: src[4*x+2], G: src[4*x+1], B: src[4*x], A: src[4*x+3]}
+				hasAlpha = hasAlpha || c.A != 0
+			}
+
+			img.SetNRGBA(x, y, c)
+		}
+	}
+
+	if bpp == 32 && !hasAlpha {
+		for i := 3; i < len(img.Pix); i += 4 {
+			img.Pix[i] = 0xFF
+		}
+	}
+
+	if mask != nil && (bpp != 32 || !hasAlpha) {
+		applyMask(img, mask, maskStride, !topDown)
+	}
+
+	return img, nil
+}
+
+// applyMask makes the pixels of img whose AND mask bit is set transparent.
+func applyMask(img *image.NRGBA, mask []byte, stride int, bottomUp bool) {
+	b := img.Bounds()
+	for y := 0; y < b.Dy(); y++ {
+		row := y
+		if bottomUp {
+			row = b.Dy() - 1 - y
+		}
+
+		for x := 0; x < b.Dx(); x++ {
+			if mask[row*stride+x/8]>>(7-x%8)&0x1 != 0 {
+				img.Pix[y*img.Stride+4*x+3] = 0
+			}
+		}
+	}
+}
+
+// EncodeDIB encodes img as a packed, bottom-up, 32-bit device-independent
+// bitmap with an alpha channel, the inverse of [DecodeDIB].
+func EncodeDIB(img image.Image) []byte {
+	return encodeDIB(img, false)
+}
+
+// encodeDIB encodes img as a 32-bit DIB. If icon is true, the header height
+// covers both the XOR bitmap and an AND mask derived from the alpha channel,
+// which follows the pixels.
+func encodeDIB(img image.Image, icon bool) []byte {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	stride := 4 * w
+	maskStride := (w + 31) / 32 * 4
+	if !icon {
+		maskStride = 0
+	}
+
+	out := make([]byte, 40+stride*h+maskStride*h)
+	binary.LittleEndian.PutUint32(out[0:], 40)
+	binary.LittleEndian.PutUint32(out[4:], uint32(w))
+	if icon {
+		binary.LittleEndian.PutUint32(out[8:], uint32(2*h))
+	} else {
+		binary.LittleEndian.PutUint32(out[8:], uint32(h))
+	}
+	binary.LittleEndian.PutUint16(out[12:], 1)
+	binary.LittleEndian.PutUint16(out[14:], 32)
+	binary.LittleEndian.PutUint32(out[20:], uint32(len(out)-40))
+
+	pix := out[40:]
+	mask := out[40+stride*h:]
+	for y := 0; y < h; y++ {
+		row := h - 1 - y
+		for x := 0; x < w; x++ {
+			c := color.NRGBAModel.Convert(img.At(b.Min.X+x, b.Min.Y+y)).(color.NRGBA)
+			p := pix[row*stride+4*x:]
+			p[0], p[1], p[2], p[3] = c.B, c.G, c.R, c.A
+			if icon && c.A == 0 {
+				mask[row*maskStride+x/8] |= 0x80 >> (x % 8)
+			}
+		}
+	}
+
+	return out
+}
+
+// imageFromBGRA converts top-down 32-bit BGRA pixels, as returned by
+// GetDIBits, to an image. If no pixel has a nonzero alpha value, the pixels
+// are treated as opaque and the optional top-down 1-bit AND mask decides
+// transparency instead.
+func imageFromBGRA(pix []byte, w, h int, mask []byte, maskStride int) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	hasAlpha := false
+	for i := 0; i+3 < len(pix) && i+3 < len(img.Pix); i += 4 {
+		img.Pix[i], img.Pix[i+1], img.Pix[i+2], img.Pix[i+3] = pix[i+2], pix[i+1], pix[i], pix[i+3]
+		hasAlpha = hasAlpha || pix[i+3] != 0
+	}
+
+	if !hasAlpha {
+		for i := 3; i < len(img.Pix); i += 4 {
+			img.Pix[i] = 0xFF
+		}
+
+		if mask != nil {
+			applyMask(img, mask, maskStride, false)
+		}
+	}
+
+	return img
+}
@@ -0,0 +1,145 @@
+package winapi_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/kamaranl/gotools/test"
+	"github.com/kamaranl/winapi"
+)
+
+// gradient returns a size x size image whose alpha increases along x and
+// whose first column is fully transparent.
+func gradient(size int) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, size, size))
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			img.SetNRGBA(x, y, color.NRGBA{R: uint8(x), G: uint8(y), B: 0x80, A: uint8(x * 255 / (size - 1))})
+		}
+	}
+
+	return img
+}
+
+func TestEncodeICO(t *testing.T) {
+	tName := "EncodeICO"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	scenes := []test.Scene{
+		{Input: []int{16}, Passing: true},
+		{Input: []int{16, 32, 48, 256}, Passing: true},
+		{Input: []int{}, Passing: false},
+		{Input: []int{512}, Passing: false},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			var imgs []image.Image
+			for _, size := range s.Input.([]int) {
+				imgs = append(imgs, gradient(size))
+			}
+
+			var buf bytes.Buffer
+			err := winapi.EncodeICO(&buf, imgs...)
+			if (err == nil) != s.Passing {
+				t.Fatalf(test.ErrUnexpectedF, err)
+			}
+			if err != nil {
+				return
+			}
+
+			got, err := winapi.DecodeICO(&buf)
+			if err != nil {
+				t.Fatalf(test.ErrUnexpectedF, err)
+			}
+			if len(got) != len(imgs) {
+				t.Fatalf(test.ErrWantFGotF, len(imgs), len(got))
+			}
+
+			for j := range imgs {
+				want := imgs[j].Bounds()
+				if got[j].Bounds() != want {
+					t.Fatalf(test.ErrWantFGotF, want, got[j].Bounds())
+				}
+
+				for y := want.Min.Y; y < want.Max.Y; y++ {
+					for x := want.Min.X; x < want.Max.X; x++ {
+						w := color.NRGBAModel.Convert(imgs[j].At(x, y))
+						g := color.NRGBAModel.Convert(got[j].At(x, y))
+						if w != g {
+							t.Fatalf("(%d,%d): "+test.ErrWantFGotF, x, y, w, g)
+						}
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestDecodeICO(t *testing.T) {
+	tName := "DecodeICO"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	// A 2x2, 1-bit icon with a black/white palette whose top-right pixel is
+	// masked out. Rows are stored bottom-up and padded to 4 bytes.
+	dib := make([]byte, 40+8+8+8)
+	binary.LittleEndian.PutUint32(dib[0:], 40)
+	binary.LittleEndian.PutUint32(dib[4:], 2)
+	binary.LittleEndian.PutUint32(dib[8:], 4)
+	binary.LittleEndian.PutUint16(dib[12:], 1)
+	binary.LittleEndian.PutUint16(dib[14:], 1)
+	copy(dib[40:], []byte{0, 0, 0, 0, 0xFF, 0xFF, 0xFF, 0})
+	copy(dib[48:], []byte{0x40, 0, 0, 0, 0x80, 0, 0, 0}) // bottom: _W, top: W_
+	copy(dib[56:], []byte{0x00, 0, 0, 0, 0x40, 0, 0, 0}) // top-right masked
+
+	ico := make([]byte, 6+16)
+	binary.LittleEndian.PutUint16(ico[2:], 1)
+	binary.LittleEndian.PutUint16(ico[4:], 1)
+	ico[6], ico[7] = 2, 2
+	binary.LittleEndian.PutUint32(ico[6+8:], uint32(len(dib)))
+	binary.LittleEndian.PutUint32(ico[6+12:], uint32(len(ico)))
+	ico = append(ico, dib...)
+
+	imgs, err := winapi.DecodeICO(bytes.NewReader(ico))
+	if err != nil {
+		t.Fatalf(test.ErrUnexpectedF, err)
+	}
+
+	white := color.NRGBA{0xFF, 0xFF, 0xFF, 0xFF}
+	black := color.NRGBA{0, 0, 0, 0xFF}
+	want := [][]color.NRGBA{
+		{white, {0, 0, 0, 0}},
+		{black, white},
+	}
+	for y, row := range want {
+		for x, w := range row {
+			if g := imgs[0].At(x, y); g != w {
+				t.Errorf("(%d,%d): "+test.ErrWantFGotF, x, y, w, g)
+			}
+		}
+	}
+
+	for n := 0; n < len(ico); n++ {
+		if _, err := winapi.DecodeICO(bytes.NewReader(ico[:n])); !errors.Is(err, winapi.ErrIconFormat) {
+			t.Errorf("truncated to %d bytes: "+test.ErrWantFGotF, n, winapi.ErrIconFormat, err)
+		}
+	}
+
+	img := gradient(8)
+	got, err := winapi.DecodeDIB(winapi.EncodeDIB(img))
+	if err != nil {
+		t.Fatalf(test.ErrUnexpectedF, err)
+	}
+	if !bytes.Equal(got.(*image.NRGBA).Pix, img.Pix) {
+		t.Errorf(test.ErrWantFGotF, img.Pix, got.(*image.NRGBA).Pix)
+	}
+}
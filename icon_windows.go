@@ -0,0 +1,160 @@
+//go:build windows
+
+package winapi
+
+import (
+	"image"
+	"runtime"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// iidIImageList is the interface identifier of IImageList.
+var iidIImageList = GUID{0x46EB5926, 0x582E, 0x4017, [8]byte{0x9F, 0xDF, 0xE8, 0x99, 0x8D, 0xAA, 0x09, 0x50}}
+
+// IImageList vtable indices and flags.
+const (
+	imageListGetIcon = 10
+	ildTransparent   = 0x1
+)
+
+// FileIcon retrieves the icon the shell displays for the file or folder at
+// path, in the size of the system image list selected by size (e.g.
+// SHIL_JUMBO for 256x256).
+// It returns nil with an error if the icon cannot be retrieved.
+//
+// Experimental: FileIcon has not been tested or used internally.
+func FileIcon(path string, size SHIL) (image.Image, error) {
+	return shellIcon(path, 0, SHGFI_SYSICONINDEX, size)
+}
+
+// ExtensionIcon retrieves the icon the shell displays for files with the
+// extension ext (e.g. ".pdf"), whether or not such a file exists, in the size
+// of the system image list selected by size.
+// It returns nil with an error if the icon cannot be retrieved.
+//
+// Experimental: ExtensionIcon has not been tested or used internally.
+func ExtensionIcon(ext string, size SHIL) (image.Image, error) {
+	return shellIcon(ext, windows.FILE_ATTRIBUTE_NORMAL, SHGFI_SYSICONINDEX|SHGFI_USEFILEATTRIBUTES, size)
+}
+
+// shellIcon looks up the system image list index of path and converts the
+// icon at that index in the image list for size.
+func shellIcon(path string, attrs uint32, flags SHGFI, size SHIL) (image.Image, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	uninit, err := coInitialize()
+	if err != nil {
+		return nil, err
+	}
+	defer uninit()
+
+	var info SHFILEINFOW
+	if _, err := SHGetFileInfoW(path, attrs, &info, flags); err != nil {
+		return nil, err
+	}
+
+	il, err := shGetImageList(size)
+	if err != nil {
+		return nil, err
+	}
+	defer il.release()
+
+	var icon Handle
+	if err := hresult(il.call(
+		imageListGetIcon,
+		uintptr(info.IconIndex),
+		ildTransparent,
+		uintptr(unsafe.Pointer(&icon)),
+	)); err != nil {
+		return nil, err
+	}
+	defer DestroyIcon(icon)
+
+	return IconImage(icon)
+}
+
+// IconImage converts the icon identified by the icon handle to an image. The
+// alpha channel of 32-bit icons is preserved; other icons get their
+// transparency from the icon's AND mask. The icon is not destroyed.
+// It returns nil with an error if the icon's bitmaps cannot be read.
+//
+// Experimental: IconImage has not been tested or used internally.
+func IconImage(icon Handle) (image.Image, error) {
+	var ii ICONINFO
+	if err := GetIconInfo(icon, &ii); err != nil {
+		return nil, err
+	}
+	defer DeleteObject(ii.Mask)
+	if ii.Color != 0 {
+		defer DeleteObject(ii.Color)
+	}
+
+	var bm BITMAP
+	if err := GetObjectW(ii.Mask, int32(unsafe.Sizeof(bm)), unsafe.Pointer(&bm)); err != nil {
+		return nil, err
+	}
+
+	dc, err := GetDC(0)
+	if err != nil {
+		return nil, err
+	}
+	defer ReleaseDC(0, dc)
+
+	w, mh := int(bm.Width), int(bm.Height)
+	mask, maskStride, err := dibBits(dc, ii.Mask, w, mh, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	if ii.Color == 0 {
+		// Monochrome: the AND mask is the upper half and the XOR mask, which
+		// holds the color, is the lower half.
+		h := mh / 2
+		pix := make([]byte, 4*w*h)
+		for y := 0; y < h; y++ {
+			for x := 0; x < w; x++ {
+				if mask[(h+y)*maskStride+x/8]>>(7-x%8)&0x1 != 0 {
+					p := pix[4*(y*w+x):]
+					p[0], p[1], p[2] = 0xFF, 0xFF, 0xFF
+				}
+			}
+		}
+
+		return imageFromBGRA(pix, w, h, mask[:h*maskStride], maskStride), nil
+	}
+
+	pix, _, err := dibBits(dc, ii.Color, w, mh, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	return imageFromBGRA(pix, w, mh, mask, maskStride), nil
+}
+
+// dibBits copies the bitmap hbm as a top-down device-independent bitmap with
+// bpp bits per pixel.
+// It returns the pixel rows and their stride, in bytes.
+func dibBits(dc, hbm Handle, w, h, bpp int) ([]byte, int, error) {
+	var bi struct {
+		hdr    BITMAPINFOHEADER
+		colors [2]uint32 // color table for 1-bit bitmaps
+	}
+	bi.hdr = BITMAPINFOHEADER{
+		Size:     uint32(unsafe.Sizeof(bi.hdr)),
+		Width:    int32(w),
+		Height:   -int32(h),
+		Planes:   1,
+		BitCount: uint16(bpp),
+	}
+
+	stride := (w*bpp + 31) / 32 * 4
+	bits := make([]byte, stride*h)
+	if _, err := GetDIBits(dc, hbm, 0, uint32(h), bits, &bi.hdr, DIB_RGB_COLORS); err != nil {
+		return nil, 0, err
+	}
+
+	return bits, stride, nil
+}
@@ -0,0 +1,40 @@
+package winapi_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kamaranl/gotools/test"
+	"github.com/kamaranl/winapi"
+)
+
+func TestIsOwnInjection(t *testing.T) {
+	tName := "IsOwnInjection"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	tag := winapi.InjectionTag()
+	if tag == 0 {
+		t.Fatalf(test.ErrUnexpectedF, "zero injection tag")
+	}
+	if tag != winapi.InjectionTag() {
+		t.Fatalf(test.ErrWantFGotF, tag, winapi.InjectionTag())
+	}
+
+	scenes := []test.Scene{
+		{Input: tag, Output: true},
+		{Input: uintptr(0), Output: false},
+		{Input: tag ^ 1, Output: false},
+		{Input: tag << 1, Output: false},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			got := winapi.IsOwnInjection(s.Input.(uintptr))
+			if want := s.Output.(bool); got != want {
+				t.Errorf(test.ErrWantFGotF, want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,350 @@
+package winapi
+
+import (
+	"fmt"
+	"strings"
+	"unsafe"
+)
+
+// This file contains the SendInput types that have the same layout on every
+// architecture. The layouts of [INPUT_Mi], [INPUT_Ki] and [INPUT_Hi], which
+// pad the INPUT union by hand, are in input_64.go and input_386.go.
+
+// #region types
+
+// An INPUT is a struct used by SendInput for synthesizing any kind of input
+// event. Unlike [INPUT_Mi], [INPUT_Ki] and [INPUT_Hi], it has the size of the
+// Win32 INPUT union on every architecture, so that a single SendInput call can
+// mix mouse, keyboard and hardware events. Build one with [NewInput] and use
+// the accessor matching Type to read its event.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-input
+type INPUT struct {
+	// Type is the type of input event.
+	Type IEvent // (DWORD)
+
+	// union holds a MOUSEINPUT, KEYBDINPUT or HARDWAREINPUT. MOUSEINPUT is the
+	// largest member, and the uintptr elements give the union its pointer
+	// alignment.
+	union [unsafe.Sizeof(MOUSEINPUT{}) / unsafe.Sizeof(uintptr(0))]uintptr
+}
+
+// IEvent represents the type of input event (mouse, keyboard, hardware).
+type IEvent uint32
+
+// [IEvent] constants.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-input#members
+const (
+	INPUT_MOUSE IEvent = iota
+	INPUT_KEYBOARD
+	INPUT_HARDWARE
+)
+
+// A MOUSEINPUT is a struct that contains information about a simulated mouse
+// event.
+//
+// See: https://learn.microsoft.com/en-us/windows/desktop/api/winuser/ns-winuser-mouseinput
+type MOUSEINPUT struct {
+	// X is the absolute x coordinate of the mouse.
+	X int32 // (LONG)
+
+	// Y is the absolute y coordinate of the mouse.
+	Y int32 // (LONG)
+
+	// MouseData specifies the amount of wheel movement if Flags contains
+	// MOUSEEVENTF_WHEEL.
+	MouseData MiData // (DWORD)
+
+	// Flags specifies various aspects of mouse motion and button clicks.
+	Flags MiFlags // (DWORD)
+
+	// Time is the timestamp for the event, in ms.
+	Time uint32 // (DWORD)
+
+	// ExtraInfo is an additional value associated with the mouse event.
+	ExtraInfo uintptr // (ULONG__PTR)
+}
+
+// A KEYBDINPUT is a struct that contains informationa about a simulated
+// keyboard event.
+//
+// See: https://learn.microsoft.com/en-us/windows/desktop/api/winuser/ns-winuser-keybdinput
+type KEYBDINPUT struct {
+	// Vk is a virtual key code within the range of 1-254.
+	Vk uint16 // (WORD)
+
+	// Scan is a hardware scan code for a key.
+	Scan uint16 // (WORD)
+
+	// Flags specifies various aspects of a keystroke.
+	Flags KiFlags // (DWORD)
+
+	// Time is the timestamp for the event, in ms.
+	Time uint32 // (DWORD)
+
+	// ExtraInfo is an additional value associated with the keystroke.
+	ExtraInfo uintptr // (ULONG__PTR)
+}
+
+// A HARDWAREINPUT is a struct that contains information about a simulated
+// message generated by an input device other than a keyboard or mouse.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-hardwareinput
+//
+// Experimental: HARDWAREINPUT has not been tested or used internally.
+type HARDWAREINPUT struct {
+	// Msg is the message generated by the input hardware.
+	Msg uint32 // (DWORD)
+
+	// ParamL is the low-order word of the lParam for Msg.
+	ParamL uint16 // (WORD)
+
+	// ParamH is the high-order word of the lParam for Msg.
+	ParamH uint16 // (WORD)
+}
+
+// MiData represents a set of MOUSEINPUT event data.
+//
+// Experimental: MiData has not been tested or used internally.
+type MiData uint32
+
+// [MiData] constants.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-mouseinput#members
+const (
+	XBUTTON1 MiData = 1 << iota
+	XBUTTON2
+)
+
+// MiFlags represents a set of MOUSEINPUT event flags.
+type MiFlags uint32
+
+// [MiFlags] constants.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-mouseinput#members
+const (
+	MOUSEEVENTF_MOVE MiFlags = 1 << iota
+	MOUSEEVENTF_LEFTDOWN
+	MOUSEEVENTF_LEFTUP
+	MOUSEEVENTF_RIGHTDOWN
+	MOUSEEVENTF_RIGHTUP
+	MOUSEEVENTF_MIDDLEDOWN
+	MOUSEEVENTF_MIDDLEUP
+	MOUSEEVENTF_XDOWN
+	MOUSEEVENTF_XUP
+	_
+	_
+	MOUSEEVENTF_WHEEL
+	MOUSEEVENTF_HWHEEL
+	MOUSEEVENTF_MOVE_NOCOALESCE
+	MOUSEEVENTF_VIRTUALDESK
+	MOUSEEVENTF_ABSOLUTE
+)
+
+// KiFlags represents a set of KEYBDINPUT event flags.
+type KiFlags uint32
+
+// [KiFlags] constants.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-keybdinput#members
+const (
+	KEYEVENTF_EXTENDEDKEY KiFlags = 1 << iota
+	KEYEVENTF_KEYUP
+	KEYEVENTF_UNICODE
+	KEYEVENTF_SCANCODE
+)
+
+// MouseButton represents a mouse button.
+type MouseButton uint8
+
+// [MouseButton] constants.
+const (
+	MouseLeft MouseButton = iota
+	MouseRight
+	MouseMiddle
+	MouseX1
+	MouseX2
+)
+
+// mouseButtons are the names of each [MouseButton], the flags and data of its
+// MOUSEINPUT events, and its flag in the wParam of mouse messages.
+var mouseButtons = [...]struct {
+	name     string
+	down, up MiFlags
+	data     MiData
+	mk       MK
+}{
+	MouseLeft:   {"left", MOUSEEVENTF_LEFTDOWN, MOUSEEVENTF_LEFTUP, 0, MK_LBUTTON},
+	MouseRight:  {"right", MOUSEEVENTF_RIGHTDOWN, MOUSEEVENTF_RIGHTUP, 0, MK_RBUTTON},
+	MouseMiddle: {"middle", MOUSEEVENTF_MIDDLEDOWN, MOUSEEVENTF_MIDDLEUP, 0, MK_MBUTTON},
+	MouseX1:     {"x1", MOUSEEVENTF_XDOWN, MOUSEEVENTF_XUP, XBUTTON1, MK_XBUTTON1},
+	MouseX2:     {"x2", MOUSEEVENTF_XDOWN, MOUSEEVENTF_XUP, XBUTTON2, MK_XBUTTON2},
+}
+
+// String returns the name of b, such as "left".
+func (b MouseButton) String() string {
+	if int(b) < len(mouseButtons) {
+		return mouseButtons[b].name
+	}
+
+	return fmt.Sprintf("button%d", b)
+}
+
+// event returns the down or up event of b.
+func (b MouseButton) event(up bool) MOUSEINPUT {
+	flags := mouseButtons[b].down
+	if up {
+		flags = mouseButtons[b].up
+	}
+
+	return MOUSEINPUT{Flags: flags, MouseData: mouseButtons[b].data}
+}
+
+// mouseButtonsOf returns the buttons that mi presses or releases.
+func mouseButtonsOf(mi *MOUSEINPUT) []MouseButton {
+	var bs []MouseButton
+	for b, info := range mouseButtons {
+		if mi.Flags&(info.down|info.up) != 0 && (info.data == 0 || mi.MouseData&info.data != 0) {
+			bs = append(bs, MouseButton(b))
+		}
+	}
+
+	return bs
+}
+
+// Mi returns the mouse event of in, valid if Type is INPUT_MOUSE.
+func (in *INPUT) Mi() *MOUSEINPUT {
+	return (*MOUSEINPUT)(unsafe.Pointer(&in.union))
+}
+
+// Ki returns the keyboard event of in, valid if Type is INPUT_KEYBOARD.
+func (in *INPUT) Ki() *KEYBDINPUT {
+	return (*KEYBDINPUT)(unsafe.Pointer(&in.union))
+}
+
+// Hi returns the hardware event of in, valid if Type is INPUT_HARDWARE.
+func (in *INPUT) Hi() *HARDWAREINPUT {
+	return (*HARDWAREINPUT)(unsafe.Pointer(&in.union))
+}
+
+// String returns a short description of the event of in, such as "key down
+// LCtrl" or "mouse move 100,200 absolute", as printed by [Macro.DryRun].
+func (in INPUT) String() string {
+	switch in.Type {
+	case INPUT_KEYBOARD:
+		ki := in.Ki()
+		dir := "down"
+		if ki.Flags&KEYEVENTF_KEYUP != 0 {
+			dir = "up"
+		}
+
+		switch {
+		case ki.Flags&KEYEVENTF_UNICODE != 0:
+			return fmt.Sprintf("char %s %U", dir, ki.Scan)
+		case ki.Flags&KEYEVENTF_SCANCODE != 0:
+			s := fmt.Sprintf("scan %s 0x%02X", dir, ki.Scan)
+			if ki.Flags&KEYEVENTF_EXTENDEDKEY != 0 {
+				s += " extended"
+			}
+			return s
+		}
+		return fmt.Sprintf("key %s %s", dir, vkName(ki.Vk))
+	case INPUT_MOUSE:
+		mi := in.Mi()
+
+		var parts []string
+		if mi.Flags&MOUSEEVENTF_MOVE != 0 {
+			move := fmt.Sprintf("move %d,%d", mi.X, mi.Y)
+			if mi.Flags&MOUSEEVENTF_ABSOLUTE != 0 {
+				move += " absolute"
+			}
+			parts = append(parts, move)
+		}
+		for _, b := range mouseButtonsOf(mi) {
+			if mi.Flags&mouseButtons[b].down != 0 {
+				parts = append(parts, b.String()+" down")
+			}
+			if mi.Flags&mouseButtons[b].up != 0 {
+				parts = append(parts, b.String()+" up")
+			}
+		}
+		if mi.Flags&MOUSEEVENTF_WHEEL != 0 {
+			parts = append(parts, fmt.Sprintf("wheel %d", int32(mi.MouseData)))
+		}
+		if mi.Flags&MOUSEEVENTF_HWHEEL != 0 {
+			parts = append(parts, fmt.Sprintf("hwheel %d", int32(mi.MouseData)))
+		}
+		return "mouse " + strings.Join(parts, ", ")
+	case INPUT_HARDWARE:
+		hi := in.Hi()
+		return fmt.Sprintf("hardware 0x%04X %d %d", hi.Msg, hi.ParamL, hi.ParamH)
+	}
+
+	return fmt.Sprintf("input type %d", in.Type)
+}
+
+// A PartialInputError is returned by SendInputN when not every event was
+// inserted into the input stream, or when the events were likely blocked by
+// User Interface Privilege Isolation (UIPI).
+type PartialInputError struct {
+	// Sent is the number of events Windows reported as inserted.
+	Sent int
+
+	// Total is the number of events passed to SendInputN.
+	Total int
+
+	// Blocked is true if the foreground window belongs to a process with a
+	// higher integrity level, which UIPI keeps injected input from.
+	Blocked bool
+
+	// Err is the error reported by the last SendInput call, if any.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *PartialInputError) Error() string {
+	msg := fmt.Sprintf("winapi: SendInput inserted %d of %d events", e.Sent, e.Total)
+	if e.Blocked {
+		msg += ", likely blocked by UIPI"
+	}
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+
+	return msg
+}
+
+// Unwrap returns the error reported by SendInput, if any.
+func (e *PartialInputError) Unwrap() error {
+	return e.Err
+}
+
+// #endregion
+
+// #region factories
+
+// NewInput returns an [INPUT] with the provided [MOUSEINPUT], [KEYBDINPUT] or
+// [HARDWAREINPUT] and the matching Type. Mouse and keyboard events are stamped
+// with the [InjectionTag] if [EnableInjectionTag] is on.
+//
+// Experimental: NewInput has not been tested or used internally.
+func NewInput[T MOUSEINPUT | KEYBDINPUT | HARDWAREINPUT](event T) (input INPUT) {
+	switch e := any(event).(type) {
+	case MOUSEINPUT:
+		input.Type = INPUT_MOUSE
+		*input.Mi() = e
+		stampExtraInfo(&input.Mi().ExtraInfo)
+	case KEYBDINPUT:
+		input.Type = INPUT_KEYBOARD
+		*input.Ki() = e
+		stampExtraInfo(&input.Ki().ExtraInfo)
+	case HARDWAREINPUT:
+		input.Type = INPUT_HARDWARE
+		*input.Hi() = e
+	}
+
+	return input
+}
+
+// #endregion
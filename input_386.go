@@ -0,0 +1,44 @@
+//go:build 386
+
+package winapi
+
+// On 32-bit Windows, the INPUT union is 4-byte aligned and 24 bytes long (the
+// size of MOUSEINPUT), for 28 bytes in total.
+
+// An INPUT_Mi is a struct used by SendInput for synthesizing mouse events.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-input#members
+type INPUT_Mi struct {
+	// Type is the type of input event, INPUT_MOUSE in this case.
+	Type IEvent // (DWORD)
+
+	// Mi is the [MOUSEINPUT] struct.
+	Mi MOUSEINPUT
+}
+
+// An INPUT_Ki is a struct used by SendInput for synthesizing keyboard events.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-input#members
+type INPUT_Ki struct {
+	// Type is the type of input event, INPUT_KEYBOARD in this case.
+	Type IEvent // (DWORD)
+
+	// Ki is the [KEYBDINPUT] struct.
+	Ki KEYBDINPUT
+
+	_ [8]byte // padding to the size of MOUSEINPUT
+}
+
+// An INPUT_Hi is a struct used by SendInput for synthesizing hardware events,
+// other than those from a keyboard or mouse.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-input#members
+type INPUT_Hi struct {
+	// Type is the type of input event, INPUT_HARDWARE in this case.
+	Type IEvent // (DWORD)
+
+	// Hi is the [HARDWAREINPUT] struct.
+	Hi HARDWAREINPUT
+
+	_ [16]byte // padding to the size of MOUSEINPUT
+}
@@ -0,0 +1,47 @@
+//go:build amd64 || arm64
+
+package winapi
+
+// On 64-bit Windows, the INPUT union holds pointer-sized members, so it is
+// 8-byte aligned and 32 bytes long (the size of MOUSEINPUT), for 40 bytes in
+// total.
+
+// An INPUT_Mi is a struct used by SendInput for synthesizing mouse events.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-input#members
+type INPUT_Mi struct {
+	// Type is the type of input event, INPUT_MOUSE in this case.
+	Type IEvent // (DWORD)
+
+	// Mi is the [MOUSEINPUT] struct.
+	Mi MOUSEINPUT
+}
+
+// An INPUT_Ki is a struct used by SendInput for synthesizing keyboard events.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-input#members
+type INPUT_Ki struct {
+	// Type is the type of input event, INPUT_KEYBOARD in this case.
+	Type IEvent // (DWORD)
+
+	// Ki is the [KEYBDINPUT] struct.
+	Ki KEYBDINPUT
+
+	_ [8]byte // padding to the size of MOUSEINPUT
+}
+
+// An INPUT_Hi is a struct used by SendInput for synthesizing hardware events,
+// other than those from a keyboard or mouse.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-input#members
+type INPUT_Hi struct {
+	// Type is the type of input event, INPUT_HARDWARE in this case.
+	Type IEvent // (DWORD)
+
+	_ [4]byte // padding to the 8-byte alignment of the union
+
+	// Hi is the [HARDWAREINPUT] struct.
+	Hi HARDWAREINPUT
+
+	_ [24]byte // padding to the size of MOUSEINPUT
+}
@@ -0,0 +1,99 @@
+package winapi_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"syscall"
+	"testing"
+	"unsafe"
+
+	"github.com/kamaranl/gotools/test"
+	"github.com/kamaranl/winapi"
+)
+
+func TestPartialInputError(t *testing.T) {
+	tName := "PartialInputError"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	scenes := []test.Scene{
+		{
+			Input:  &winapi.PartialInputError{Sent: 2, Total: 5},
+			Output: "winapi: SendInput inserted 2 of 5 events",
+		},
+		{
+			Input:  &winapi.PartialInputError{Sent: 5, Total: 5, Blocked: true},
+			Output: "winapi: SendInput inserted 5 of 5 events, likely blocked by UIPI",
+		},
+		{
+			Input:  &winapi.PartialInputError{Sent: 0, Total: 1, Err: syscall.EINVAL},
+			Output: "winapi: SendInput inserted 0 of 1 events: " + syscall.EINVAL.Error(),
+		},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			e := s.Input.(*winapi.PartialInputError)
+			if got := e.Error(); got != s.Output.(string) {
+				t.Errorf(test.ErrWantFGotF, s.Output, got)
+			}
+
+			var err error = e
+			var target *winapi.PartialInputError
+			if !errors.As(err, &target) || target != e {
+				t.Errorf(test.ErrWantFGotF, e, target)
+			}
+			if got := errors.Is(err, syscall.EINVAL); got != (e.Err != nil) {
+				t.Errorf(test.ErrWantFGotF, e.Err != nil, got)
+			}
+		})
+	}
+}
+
+func TestNewInput(t *testing.T) {
+	tName := "NewInput"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	// The Win32 INPUT struct is 40 bytes on 64-bit Windows and 28 on 32-bit.
+	want := uintptr(28)
+	if unsafe.Sizeof(uintptr(0)) == 8 {
+		want = 40
+	}
+	if got := unsafe.Sizeof(winapi.INPUT{}); got != want {
+		t.Fatalf(test.ErrWantFGotF, want, got)
+	}
+
+	mi := winapi.MOUSEINPUT{X: 400, Y: 600, Flags: winapi.MOUSEEVENTF_ABSOLUTE, ExtraInfo: 1}
+	ki := winapi.KEYBDINPUT{Scan: 35, Flags: winapi.KEYEVENTF_SCANCODE, ExtraInfo: 2}
+	hi := winapi.HARDWAREINPUT{Msg: 0x0012, ParamL: 3, ParamH: 4}
+
+	scenes := []test.Scene{
+		{Input: winapi.NewInput(mi), Output: mi},
+		{Input: winapi.NewInput(ki), Output: ki},
+		{Input: winapi.NewInput(hi), Output: hi},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			in := s.Input.(winapi.INPUT)
+
+			var got any
+			switch in.Type {
+			case winapi.INPUT_MOUSE:
+				got = *in.Mi()
+			case winapi.INPUT_KEYBOARD:
+				got = *in.Ki()
+			case winapi.INPUT_HARDWARE:
+				got = *in.Hi()
+			}
+
+			if !reflect.DeepEqual(got, s.Output) {
+				t.Errorf(test.ErrWantFGotF, s.Output, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package winapi
+
+import "slices"
+
+// An InputLedger records the keys and mouse buttons pressed by input events
+// and not yet released by them, so that they can be released if a sequence is
+// cut short. It only knows about the events it is given, not about the state
+// of the real devices.
+//
+// The zero value is an empty ledger.
+type InputLedger struct {
+	held []heldInput // in press order
+}
+
+// heldInput is a key or button held down, and the event that pressed it.
+type heldInput struct {
+	id   inputID
+	down INPUT
+}
+
+// inputID identifies a key, by virtual key, scan code or character, or a mouse
+// button.
+type inputID struct {
+	typ  IEvent
+	kind KiFlags // KEYEVENTF_SCANCODE or KEYEVENTF_UNICODE, or 0 for a virtual key
+	code uint32
+}
+
+// Record updates l with inputs, in order: key and button down events add to
+// it, and up events remove from it. Repeated down events of a held key, as
+// sent by auto-repeat, are recorded once.
+//
+// Experimental: Record has not been tested or used internally.
+func (l *InputLedger) Record(inputs ...INPUT) {
+	for i := range inputs {
+		in := &inputs[i]
+		switch in.Type {
+		case INPUT_KEYBOARD:
+			ki := in.Ki()
+			id := keyID(ki)
+			if ki.Flags&KEYEVENTF_KEYUP != 0 {
+				l.release(id)
+			} else {
+				l.press(id, *in)
+			}
+		case INPUT_MOUSE:
+			mi := in.Mi()
+			for _, b := range mouseButtonsOf(mi) {
+				id := inputID{typ: INPUT_MOUSE, code: uint32(b)}
+				if mi.Flags&mouseButtons[b].down != 0 {
+					l.press(id, NewInput(b.event(false)))
+				}
+				if mi.Flags&mouseButtons[b].up != 0 {
+					l.release(id)
+				}
+			}
+		}
+	}
+}
+
+// Held returns the down events of the keys and buttons held, in the order
+// they were pressed.
+//
+// Experimental: Held has not been tested or used internally.
+func (l *InputLedger) Held() []INPUT {
+	held := make([]INPUT, len(l.held))
+	for i, h := range l.held {
+		held[i] = h.down
+	}
+
+	return held
+}
+
+// Releases returns the up events that release every key and button held, in
+// the reverse order they were pressed, so that modifiers are released last.
+//
+// Experimental: Releases has not been tested or used internally.
+func (l *InputLedger) Releases() []INPUT {
+	ups := make([]INPUT, 0, len(l.held))
+	for _, h := range slices.Backward(l.held) {
+		down := h.down
+		switch down.Type {
+		case INPUT_KEYBOARD:
+			ki := *down.Ki()
+			ki.Flags |= KEYEVENTF_KEYUP
+			ki.Time = 0
+			ups = append(ups, NewInput(ki))
+		case INPUT_MOUSE:
+			ups = append(ups, NewInput(MouseButton(h.id.code).event(true)))
+		}
+	}
+
+	return ups
+}
+
+// Reset removes every key and button from l, e.g. once their release has
+// been sent.
+//
+// Experimental: Reset has not been tested or used internally.
+func (l *InputLedger) Reset() {
+	l.held = nil
+}
+
+func (l *InputLedger) press(id inputID, down INPUT) {
+	if !slices.ContainsFunc(l.held, func(h heldInput) bool { return h.id == id }) {
+		l.held = append(l.held, heldInput{id: id, down: down})
+	}
+}
+
+func (l *InputLedger) release(id inputID) {
+	l.held = slices.DeleteFunc(l.held, func(h heldInput) bool { return h.id == id })
+}
+
+// keyID identifies the key of ki the way Windows does: by character for
+// KEYEVENTF_UNICODE, by scan code and extended flag for KEYEVENTF_SCANCODE,
+// and by virtual key otherwise.
+func keyID(ki *KEYBDINPUT) inputID {
+	switch {
+	case ki.Flags&KEYEVENTF_UNICODE != 0:
+		return inputID{typ: INPUT_KEYBOARD, kind: KEYEVENTF_UNICODE, code: uint32(ki.Scan)}
+	case ki.Flags&KEYEVENTF_SCANCODE != 0:
+		code := uint32(ki.Scan)
+		if ki.Flags&KEYEVENTF_EXTENDEDKEY != 0 {
+			code |= 0xE000
+		}
+		return inputID{typ: INPUT_KEYBOARD, kind: KEYEVENTF_SCANCODE, code: code}
+	}
+
+	return inputID{typ: INPUT_KEYBOARD, code: uint32(ki.Vk)}
+}
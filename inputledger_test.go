@@ -0,0 +1,97 @@
+package winapi_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/kamaranl/gotools/test"
+	"github.com/kamaranl/winapi"
+)
+
+// events returns the MOUSEINPUT or KEYBDINPUT of each input, which unlike
+// INPUT values can be compared without the padding of the union.
+func events(inputs []winapi.INPUT) []any {
+	evs := []any{}
+	for i := range inputs {
+		switch inputs[i].Type {
+		case winapi.INPUT_MOUSE:
+			evs = append(evs, *inputs[i].Mi())
+		case winapi.INPUT_KEYBOARD:
+			evs = append(evs, *inputs[i].Ki())
+		}
+	}
+
+	return evs
+}
+
+func TestInputLedger(t *testing.T) {
+	tName := "InputLedger"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	key := func(vk uint16, flags winapi.KiFlags) winapi.INPUT {
+		return winapi.NewInput(winapi.KEYBDINPUT{Vk: vk, Flags: flags})
+	}
+	scan := func(sc uint16, flags winapi.KiFlags) winapi.INPUT {
+		return winapi.NewInput(winapi.KEYBDINPUT{Scan: sc, Flags: winapi.KEYEVENTF_SCANCODE | flags})
+	}
+	mouse := func(flags winapi.MiFlags, data winapi.MiData) winapi.INPUT {
+		return winapi.NewInput(winapi.MOUSEINPUT{Flags: flags, MouseData: data})
+	}
+	up := winapi.KEYEVENTF_KEYUP
+
+	scenes := []test.Scene{
+		{
+			Input:  []winapi.INPUT{key(winapi.VK_LCONTROL, 0), key('C', 0), key('C', up)},
+			Output: []winapi.INPUT{key(winapi.VK_LCONTROL, up)},
+		},
+		{
+			Input:  []winapi.INPUT{key(winapi.VK_LSHIFT, 0), key('A', 0), key('A', 0)},
+			Output: []winapi.INPUT{key('A', up), key(winapi.VK_LSHIFT, up)},
+		},
+		{
+			Input:  []winapi.INPUT{scan(0x1D, 0), scan(0x1D, winapi.KEYEVENTF_EXTENDEDKEY), scan(0x1D, up)},
+			Output: []winapi.INPUT{scan(0x1D, winapi.KEYEVENTF_EXTENDEDKEY|up)},
+		},
+		{
+			Input: []winapi.INPUT{
+				mouse(winapi.MOUSEEVENTF_LEFTDOWN|winapi.MOUSEEVENTF_RIGHTDOWN, 0),
+				mouse(winapi.MOUSEEVENTF_LEFTUP, 0),
+			},
+			Output: []winapi.INPUT{mouse(winapi.MOUSEEVENTF_RIGHTUP, 0)},
+		},
+		{
+			Input: []winapi.INPUT{
+				mouse(winapi.MOUSEEVENTF_XDOWN, winapi.XBUTTON1|winapi.XBUTTON2),
+				mouse(winapi.MOUSEEVENTF_XUP, winapi.XBUTTON1),
+			},
+			Output: []winapi.INPUT{mouse(winapi.MOUSEEVENTF_XUP, winapi.XBUTTON2)},
+		},
+		{
+			Input:  []winapi.INPUT{mouse(winapi.MOUSEEVENTF_LEFTDOWN|winapi.MOUSEEVENTF_LEFTUP, 0)},
+			Output: []winapi.INPUT{},
+		},
+		{
+			Input:  []winapi.INPUT{key('A', up), mouse(winapi.MOUSEEVENTF_MOVE, 0)},
+			Output: []winapi.INPUT{},
+		},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			var l winapi.InputLedger
+			l.Record(s.Input.([]winapi.INPUT)...)
+
+			if got, want := events(l.Releases()), events(s.Output.([]winapi.INPUT)); !reflect.DeepEqual(got, want) {
+				t.Errorf(test.ErrWantFGotF, want, got)
+			}
+
+			l.Record(l.Releases()...)
+			if got := l.Held(); len(got) != 0 {
+				t.Errorf(test.ErrWantFGotF, 0, len(got))
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package winapi
+
+import (
+	"math/rand/v2"
+	"slices"
+	"time"
+)
+
+// An InputBatch is a group of input events that an [InputQueue] sends with a
+// single SendInput call, so that no other input is interleaved with them.
+type InputBatch struct {
+	// At is when the batch is sent, relative to the start of the queue.
+	At time.Duration
+
+	// Inputs are the events of the batch, in order.
+	Inputs []INPUT
+}
+
+// An InputQueue schedules input events and sends them at their due time. The
+// events of contiguous actions due at the same time are grouped into a single
+// [InputBatch], and so a single SendInput call.
+//
+// The zero value is an empty queue without jitter.
+type InputQueue struct {
+	// Jitter is the maximum random delay added to the wait before each batch
+	// that is not due at the same time as the previous one. Jitter pushes
+	// back all later batches, so the spacing between batches never shrinks.
+	Jitter time.Duration
+
+	// Rand is the source of the jitter. If nil, the global source of
+	// math/rand/v2 is used.
+	Rand *rand.Rand
+
+	batches []InputBatch
+	end     time.Duration
+}
+
+// Add schedules inputs delay after the previous action of q, or after the
+// start of q if it is empty. With a delay of 0, inputs join the batch of the
+// previous action.
+//
+// Experimental: Add has not been tested or used internally.
+func (q *InputQueue) Add(delay time.Duration, inputs ...INPUT) {
+	q.AddAt(q.end+max(delay, 0), inputs...)
+}
+
+// AddAt schedules inputs at, relative to the start of q. Actions are sent in
+// the order they are added, so an at before the previous action of q is
+// treated as the time of that action.
+//
+// Experimental: AddAt has not been tested or used internally.
+func (q *InputQueue) AddAt(at time.Duration, inputs ...INPUT) {
+	if len(inputs) == 0 {
+		return
+	}
+
+	at = max(at, q.end)
+	q.end = at
+
+	if n := len(q.batches); n > 0 && q.batches[n-1].At == at {
+		q.batches[n-1].Inputs = append(q.batches[n-1].Inputs, inputs...)
+		return
+	}
+
+	q.batches = append(q.batches, InputBatch{At: at, Inputs: slices.Clone(inputs)})
+}
+
+// Plan returns the batches q sends, without jitter, in order.
+//
+// Experimental: Plan has not been tested or used internally.
+func (q *InputQueue) Plan() []InputBatch {
+	plan := make([]InputBatch, len(q.batches))
+	for i, b := range q.batches {
+		plan[i] = InputBatch{At: b.At, Inputs: slices.Clone(b.Inputs)}
+	}
+
+	return plan
+}
+
+// Duration returns when the last batch of q is sent, without jitter.
+//
+// Experimental: Duration has not been tested or used internally.
+func (q *InputQueue) Duration() time.Duration {
+	return q.end
+}
+
+// Reset removes every action from q.
+//
+// Experimental: Reset has not been tested or used internally.
+func (q *InputQueue) Reset() {
+	q.batches, q.end = nil, 0
+}
+
+// schedule returns the due time of each batch of q, relative to the start of
+// q, with jitter added.
+func (q *InputQueue) schedule() []time.Duration {
+	due := make([]time.Duration, len(q.batches))
+
+	var shift time.Duration
+	for i, b := range q.batches {
+		if i > 0 && q.Jitter > 0 {
+			if q.Rand != nil {
+				shift += time.Duration(q.Rand.Int64N(int64(q.Jitter)))
+			} else {
+				shift += rand.N(q.Jitter)
+			}
+		}
+
+		due[i] = b.At + shift
+	}
+
+	return due
+}
@@ -0,0 +1,114 @@
+package winapi_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kamaranl/gotools/test"
+	"github.com/kamaranl/winapi"
+)
+
+func TestInputQueuePlan(t *testing.T) {
+	tName := "InputQueuePlan"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	key := func(vk uint16) winapi.INPUT { return winapi.NewInput(winapi.KEYBDINPUT{Vk: vk}) }
+	click := winapi.NewInput(winapi.MOUSEINPUT{Flags: winapi.MOUSEEVENTF_LEFTDOWN})
+	ms := time.Millisecond
+
+	type action struct {
+		at     bool
+		d      time.Duration
+		inputs []winapi.INPUT
+	}
+
+	scenes := []test.Scene{
+		{
+			// contiguous zero-delay actions share a batch
+			Input: []action{
+				{d: 0, inputs: []winapi.INPUT{key(0x11)}},
+				{d: 0, inputs: []winapi.INPUT{click}},
+				{d: 0, inputs: []winapi.INPUT{key(0x12), key(0x13)}},
+			},
+			Output: []winapi.InputBatch{
+				{At: 0, Inputs: []winapi.INPUT{key(0x11), click, key(0x12), key(0x13)}},
+			},
+		},
+		{
+			// delays are relative to the previous action
+			Input: []action{
+				{d: 5 * ms, inputs: []winapi.INPUT{key(1)}},
+				{d: 0, inputs: []winapi.INPUT{key(2)}},
+				{d: 10 * ms, inputs: []winapi.INPUT{key(3)}},
+				{d: -1, inputs: []winapi.INPUT{key(4)}},
+			},
+			Output: []winapi.InputBatch{
+				{At: 5 * ms, Inputs: []winapi.INPUT{key(1), key(2)}},
+				{At: 15 * ms, Inputs: []winapi.INPUT{key(3), key(4)}},
+			},
+		},
+		{
+			// timestamps are absolute, and never reorder actions
+			Input: []action{
+				{at: true, d: 20 * ms, inputs: []winapi.INPUT{key(1)}},
+				{at: true, d: 10 * ms, inputs: []winapi.INPUT{key(2)}},
+				{at: true, d: 30 * ms, inputs: []winapi.INPUT{key(3)}},
+				{d: 1 * ms, inputs: []winapi.INPUT{key(4)}},
+				{d: 1 * ms},
+			},
+			Output: []winapi.InputBatch{
+				{At: 20 * ms, Inputs: []winapi.INPUT{key(1), key(2)}},
+				{At: 30 * ms, Inputs: []winapi.INPUT{key(3)}},
+				{At: 31 * ms, Inputs: []winapi.INPUT{key(4)}},
+			},
+		},
+		{
+			Input:  []action{},
+			Output: []winapi.InputBatch{},
+		},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			var q winapi.InputQueue
+			for _, a := range s.Input.([]action) {
+				if a.at {
+					q.AddAt(a.d, a.inputs...)
+				} else {
+					q.Add(a.d, a.inputs...)
+				}
+			}
+
+			want := s.Output.([]winapi.InputBatch)
+			got := q.Plan()
+			if !reflect.DeepEqual(got, want) {
+				t.Fatalf(test.ErrWantFGotF, want, got)
+			}
+
+			var end time.Duration
+			if len(want) > 0 {
+				end = want[len(want)-1].At
+			}
+			if q.Duration() != end {
+				t.Errorf(test.ErrWantFGotF, end, q.Duration())
+			}
+
+			// the plan is a copy
+			if len(got) > 0 {
+				got[0].Inputs[0] = winapi.INPUT{}
+				if !reflect.DeepEqual(q.Plan(), want) {
+					t.Errorf(test.ErrUnexpectedF, "plan shares its inputs with the queue")
+				}
+			}
+
+			q.Reset()
+			if len(q.Plan()) != 0 || q.Duration() != 0 {
+				t.Errorf(test.ErrWantFGotF, "empty queue", q.Plan())
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+//go:build windows
+
+package winapi
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/sys/windows"
+)
+
+// Run sends the batches of q at their due time, plus jitter, measured from
+// the call to Run. The waits use a high-resolution waitable timer where
+// available (Windows 10, version 1803 and later), so they are not rounded up
+// to the 15.6 ms default timer resolution.
+// It returns ctx.Err() if ctx is done before the last batch is sent, or the
+// error of [SendInputN] if a batch is not fully inserted.
+//
+// Experimental: Run has not been tested or used internally.
+func (q *InputQueue) Run(ctx context.Context) error {
+	return q.run(ctx, 0, nil)
+}
+
+// run sends the batches of q like Run. When skip is signaled, the current
+// wait ends at once and the later batches keep their spacing. The events
+// inserted are recorded in ledger, if not nil.
+func (q *InputQueue) run(ctx context.Context, skip windows.Handle, ledger *InputLedger) error {
+	if len(q.batches) == 0 {
+		return ctx.Err()
+	}
+
+	timer, err := CreateWaitableTimerExW("", CREATE_WAITABLE_TIMER_HIGH_RESOLUTION, TIMER_ALL_ACCESS)
+	if err != nil {
+		if timer, err = CreateWaitableTimerExW("", 0, TIMER_ALL_ACCESS); err != nil {
+			return err
+		}
+	}
+	defer windows.CloseHandle(timer)
+
+	cancel, err := windows.CreateEvent(nil, 1, 0, nil)
+	if err != nil {
+		return err
+	}
+	defer windows.CloseHandle(cancel)
+
+	stop := context.AfterFunc(ctx, func() { windows.SetEvent(cancel) })
+	defer stop()
+
+	handles := []windows.Handle{timer, cancel}
+	if skip != 0 {
+		handles = append(handles, skip)
+	}
+
+	start := time.Now()
+	for i, due := range q.schedule() {
+		if wait := due - time.Since(start); wait > 0 {
+			// Relative due times are negative, in 100-nanosecond intervals.
+			if err := SetWaitableTimer(timer, -int64(wait/100), 0); err != nil {
+				return err
+			}
+
+			ev, err := windows.WaitForMultipleObjects(handles, false, windows.INFINITE)
+			if err != nil {
+				return err
+			}
+			switch ev {
+			case windows.WAIT_OBJECT_0:
+			case windows.WAIT_OBJECT_0 + 2:
+				// Move the start back so that the batch is due now.
+				start = start.Add(-(due - time.Since(start)))
+			default:
+				return ctx.Err()
+			}
+		}
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		n, err := SendInputN(q.batches[i].Inputs, 0)
+		if ledger != nil {
+			ledger.Record(q.batches[i].Inputs[:n]...)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+//go:build windows
+
+package winapi
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrSessionClosed is returned when sending input with a closed
+// [InputSession].
+var ErrSessionClosed = errors.New("winapi: input session closed")
+
+// An InputSession sends input events and records, in an [InputLedger], the
+// keys and mouse buttons they leave held down, so that they are released when
+// the session ends rather than left stuck. A session is safe for concurrent
+// use.
+//
+// To release the held keys on panic as well as on return, defer Close right
+// after creating the session:
+//
+//	s := winapi.NewInputSession(ctx)
+//	defer s.Close()
+type InputSession struct {
+	mu     sync.Mutex
+	ledger InputLedger
+	closed bool
+	stop   func() bool
+}
+
+// NewInputSession returns an [InputSession] that releases its held keys and
+// buttons, and closes, when ctx is done.
+//
+// Experimental: NewInputSession has not been tested or used internally.
+func NewInputSession(ctx context.Context) *InputSession {
+	s := &InputSession{}
+
+	// Close may run right away if ctx is already done, so s.stop is set under
+	// the lock it reads it with.
+	s.mu.Lock()
+	s.stop = context.AfterFunc(ctx, func() { s.Close() })
+	s.mu.Unlock()
+
+	return s
+}
+
+// Send sends inputs with [SendInputN] and records the events that were
+// inserted in the ledger of s.
+// It returns [ErrSessionClosed] if s is closed, or the error of [SendInputN].
+//
+// Experimental: Send has not been tested or used internally.
+func (s *InputSession) Send(inputs ...INPUT) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return ErrSessionClosed
+	}
+
+	n, err := SendInputN(inputs, 0)
+	s.ledger.Record(inputs[:n]...)
+
+	return err
+}
+
+// Held returns the down events of the keys and buttons s holds, in the order
+// they were pressed.
+//
+// Experimental: Held has not been tested or used internally.
+func (s *InputSession) Held() []INPUT {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.ledger.Held()
+}
+
+// Release sends the up events of every key and button s holds, in the reverse
+// order they were pressed. The session stays open.
+// It returns the error of [SendInputN], in which case the keys not released
+// stay in the ledger.
+//
+// Experimental: Release has not been tested or used internally.
+func (s *InputSession) Release() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.release()
+}
+
+// Close releases every key and button s holds, like [InputSession.Release],
+// and closes s. Closing a closed session does nothing.
+//
+// Experimental: Close has not been tested or used internally.
+func (s *InputSession) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	if s.stop != nil {
+		s.stop()
+	}
+
+	return s.release()
+}
+
+func (s *InputSession) release() error {
+	ups := s.ledger.Releases()
+	if len(ups) == 0 {
+		return nil
+	}
+
+	n, err := SendInputN(ups, 0)
+	s.ledger.Record(ups[:n]...)
+
+	return err
+}
+
+// modifierKeys are the modifier keys [ReleaseAllModifiers] checks.
+var modifierKeys = [...]uint16{
+	VK_LSHIFT, VK_RSHIFT, VK_LCONTROL, VK_RCONTROL, VK_LMENU, VK_RMENU, VK_LWIN, VK_RWIN,
+}
+
+// ReleaseAllModifiers sends an up event for each Shift, Ctrl, Alt and Windows
+// key that [GetAsyncKeyState] reports down, whoever pressed it. Unlike
+// [InputSession.Release], it acts on the real key state, so it also frees keys
+// left stuck by another process or a crashed run.
+// It returns the error of [SendInputN].
+//
+// Experimental: ReleaseAllModifiers has not been tested or used internally.
+func ReleaseAllModifiers() error {
+	var ups []INPUT
+	for _, vk := range modifierKeys {
+		if GetAsyncKeyState(byte(vk)) {
+			ups = append(ups, NewInput(keyEvent(vk, true)))
+		}
+	}
+
+	if len(ups) == 0 {
+		return nil
+	}
+
+	_, err := SendInputN(ups, 0)
+
+	return err
+}
@@ -0,0 +1,88 @@
+//go:build windows
+
+package winapi
+
+import (
+	"sync"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// ProcessIntegrityLevel retrieves the mandatory integrity level of the process
+// identified by pid, or of the calling process if pid is 0.
+// It returns an error if the process or its token cannot be opened.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/secauthz/mandatory-integrity-control
+//
+// Experimental: ProcessIntegrityLevel has not been tested or used internally.
+func ProcessIntegrityLevel(pid uint32) (IntegrityLevel, error) {
+	process := windows.CurrentProcess()
+	if pid != 0 {
+		h, err := windows.OpenProcess(windows.PROCESS_QUERY_LIMITED_INFORMATION, false, pid)
+		if err != nil {
+			return 0, err
+		}
+		defer windows.CloseHandle(h)
+
+		process = h
+	}
+
+	var token windows.Token
+	if err := windows.OpenProcessToken(process, windows.TOKEN_QUERY, &token); err != nil {
+		return 0, err
+	}
+	defer token.Close()
+
+	n := uint32(64)
+	for {
+		buf := make([]byte, n)
+		err := windows.GetTokenInformation(token, windows.TokenIntegrityLevel, &buf[0], n, &n)
+		if err == windows.ERROR_INSUFFICIENT_BUFFER {
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		label := (*windows.Tokenmandatorylabel)(unsafe.Pointer(&buf[0]))
+		sid := label.Label.Sid
+
+		return IntegrityLevel(sid.SubAuthority(uint32(sid.SubAuthorityCount()) - 1)), nil
+	}
+}
+
+// ownIntegrityLevel returns the integrity level of the calling process, which
+// does not change while it runs, so that [uipiBlocked] reads its token once.
+var ownIntegrityLevel = sync.OnceValues(func() (IntegrityLevel, error) {
+	return ProcessIntegrityLevel(0)
+})
+
+// uipiBlocked reports whether the foreground window belongs to a process with
+// a higher integrity level than the calling process, so that User Interface
+// Privilege Isolation keeps input injected by the calling process from it.
+// Processes whose integrity level cannot be read, such as protected ones, are
+// assumed to be blocking.
+func uipiBlocked() bool {
+	hwnd := windows.GetForegroundWindow()
+	if hwnd == 0 {
+		return false
+	}
+
+	var pid uint32
+	if _, err := windows.GetWindowThreadProcessId(hwnd, &pid); err != nil || pid == windows.GetCurrentProcessId() {
+		return false
+	}
+
+	own, err := ownIntegrityLevel()
+	if err != nil {
+		return false
+	}
+
+	fg, err := ProcessIntegrityLevel(pid)
+	if err != nil {
+		return own < SECURITY_MANDATORY_HIGH_RID
+	}
+
+	return fg > own
+}
@@ -0,0 +1,419 @@
+package winapi
+
+import (
+	"bufio"
+	"bytes"
+	"embed"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+//go:generate go run gen.go
+
+// layoutFiles holds the built-in keyboard layouts, one file per BCP 47
+// language tag, and keys.txt with the keys they share.
+//
+//go:embed layouts/*.txt
+var layoutFiles embed.FS
+
+// ShiftState represents the modifier keys a character is typed with, in the
+// layout of the high byte returned by VkKeyScanExW.
+type ShiftState uint8
+
+// [ShiftState] constants.
+const (
+	StateShift ShiftState = 1 << iota
+	StateCtrl
+	StateAlt
+	StateKana // the kana lock of Japanese keyboards is on
+
+	StateAltGr = StateCtrl | StateAlt
+)
+
+// A KeyStroke is a physical key, and the shift state it is pressed in to type
+// a character.
+type KeyStroke struct {
+	Vk       uint16
+	Scan     uint16
+	Extended bool
+	Shift    ShiftState
+
+	// Dead is set for a dead key, which types its character only with the
+	// next key, such as Space.
+	Dead bool
+}
+
+// A KeyboardLayout is a built-in table of the keys of a keyboard layout, to
+// map between characters, virtual keys and scan codes without a live Windows
+// system, or when the layout is not installed. Only characters typed by a
+// single key are mapped: those typed with a dead key, such as "ê", are not.
+type KeyboardLayout struct {
+	// Tag is the BCP 47 language tag of the layout, such as "de-DE".
+	Tag string
+
+	// KLID is the identifier of the layout on Windows.
+	KLID KLID
+
+	keys   []layoutKey
+	shifts []ShiftState
+	chars  map[rune]KeyStroke
+}
+
+// A layoutKey is a key of a [KeyboardLayout] and the characters it types in
+// the shift states of the layout, or 0 for none.
+type layoutKey struct {
+	scan     uint16
+	extended bool
+	vk       uint16
+	chars    []rune
+	dead     []bool
+}
+
+// builtinLayouts parses the embedded layouts once. They are generated, so an
+// error in them is a bug of the package.
+var builtinLayouts = sync.OnceValue(func() map[string]*KeyboardLayout {
+	common, err := parseLayout("keys", mustReadLayout("keys.txt"), nil)
+	if err != nil {
+		panic(err)
+	}
+
+	entries, err := layoutFiles.ReadDir("layouts")
+	if err != nil {
+		panic(err)
+	}
+
+	layouts := make(map[string]*KeyboardLayout)
+	for _, e := range entries {
+		tag := strings.TrimSuffix(e.Name(), ".txt")
+		if tag == "keys" {
+			continue
+		}
+
+		l, err := parseLayout(tag, mustReadLayout(e.Name()), common.keys)
+		if err != nil {
+			panic(err)
+		}
+		layouts[strings.ToLower(tag)] = l
+	}
+
+	return layouts
+})
+
+// mustReadLayout returns the embedded layout file name.
+func mustReadLayout(name string) []byte {
+	data, err := layoutFiles.ReadFile(path.Join("layouts", name))
+	if err != nil {
+		panic(err)
+	}
+
+	return data
+}
+
+// BuiltinLayout returns the built-in layout of the BCP 47 language tag, which
+// is case-insensitive and may use underscores. A tag without a region, such as
+// "de", returns the layout of the language in its main region.
+// It returns false if there is no built-in layout for tag.
+func BuiltinLayout(tag string) (*KeyboardLayout, bool) {
+	layouts := builtinLayouts()
+	if l, ok := layouts[strings.ToLower(strings.ReplaceAll(tag, "_", "-"))]; ok {
+		return l, true
+	}
+
+	if id, ok := LangIDForTag(tag); ok {
+		l, ok := layouts[strings.ToLower(id.Tag())]
+		return l, ok
+	}
+
+	return nil, false
+}
+
+// BuiltinLayouts returns the language tags of the built-in layouts, sorted.
+func BuiltinLayouts() []string {
+	var tags []string
+	for _, l := range builtinLayouts() {
+		tags = append(tags, l.Tag)
+	}
+	sort.Strings(tags)
+
+	return tags
+}
+
+// KeyStroke returns the key and shift state that type r with l, preferring
+// the fewest modifiers. Line breaks and tabs are typed with Enter and Tab.
+// It returns false if no single key of l types r.
+func (l *KeyboardLayout) KeyStroke(r rune) (KeyStroke, bool) {
+	switch r {
+	case '\r', '\n':
+		r = VK_RETURN
+	case '\t':
+		r = VK_TAB
+	default:
+		ks, ok := l.chars[r]
+		return ks, ok
+	}
+
+	scan, ext, ok := l.ScanCode(uint16(r))
+
+	return KeyStroke{Vk: uint16(r), Scan: scan, Extended: ext}, ok
+}
+
+// Char returns the character typed by the key vk in the shift state shift,
+// and whether the key is a dead key in that state.
+// It returns false if the key types no character in that state.
+func (l *KeyboardLayout) Char(vk uint16, shift ShiftState) (r rune, dead bool, ok bool) {
+	for _, k := range l.keys {
+		if k.vk != vk {
+			continue
+		}
+
+		for i, s := range l.shifts {
+			if s == shift && k.chars[i] != 0 {
+				return k.chars[i], k.dead[i], true
+			}
+		}
+	}
+
+	return 0, false, false
+}
+
+// ScanCode returns the scan code of the key vk, and whether it is an extended
+// key, as MapVirtualKeyExW does with MAPVK_VK_TO_VSC_EX. A virtual key with
+// several keys, such as VK_RETURN, returns the one of the main keyboard.
+// It returns false if vk is not in l.
+func (l *KeyboardLayout) ScanCode(vk uint16) (scan uint16, extended bool, ok bool) {
+	for _, k := range l.keys {
+		if k.vk == vk {
+			return k.scan, k.extended, true
+		}
+	}
+
+	return 0, false, false
+}
+
+// VirtualKey returns the virtual key of the key with the scan code scan, as
+// MapVirtualKeyExW does with MAPVK_VSC_TO_VK_EX. The keys of the numeric
+// keypad return their virtual keys with Num Lock on, such as VK_NUMPAD7.
+// It returns false if the key is not in l.
+func (l *KeyboardLayout) VirtualKey(scan uint16, extended bool) (uint16, bool) {
+	for _, k := range l.keys {
+		if k.scan == scan && k.extended == extended {
+			return k.vk, true
+		}
+	}
+
+	return 0, false
+}
+
+// TypeEvents returns the key events that type text with l, using virtual keys
+// and scan codes rather than KEYEVENTF_UNICODE, for programs that read key
+// codes. Each character is typed with its modifiers pressed around it: AltGr
+// as left Ctrl+Alt, and the kana lock of Japanese keyboards by toggling VK_KANA,
+// which is assumed to be off. A dead key is followed by Space to type its
+// character on its own.
+// It returns an error if l has no key for a character of text.
+func (l *KeyboardLayout) TypeEvents(text string) ([]INPUT, error) {
+	var events []INPUT
+	tap := func(vk, scan uint16, ext bool) {
+		ki := KEYBDINPUT{Vk: vk, Scan: scan}
+		if ext {
+			ki.Flags |= KEYEVENTF_EXTENDEDKEY
+		}
+		events = append(events, NewInput(ki))
+		ki.Flags |= KEYEVENTF_KEYUP
+		events = append(events, NewInput(ki))
+	}
+	press := func(vk uint16, up bool) {
+		scan, ext, _ := l.ScanCode(vk)
+		ki := keyEvent(vk, up)
+		ki.Scan = scan
+		if ext {
+			ki.Flags |= KEYEVENTF_EXTENDEDKEY
+		}
+		events = append(events, NewInput(ki))
+	}
+
+	prev := rune(0)
+	for _, r := range text {
+		if r == '\n' && prev == '\r' {
+			prev = r
+			continue
+		}
+		prev = r
+
+		ks, ok := l.KeyStroke(r)
+		if !ok {
+			return nil, fmt.Errorf("winapi: layout %s has no key for %q", l.Tag, r)
+		}
+
+		var mods []uint16
+		if ks.Shift&StateKana != 0 {
+			scan, _, _ := l.ScanCode(VK_KANA)
+			tap(VK_KANA, scan, false)
+		}
+		if ks.Shift&StateCtrl != 0 {
+			mods = append(mods, VK_LCONTROL)
+		}
+		if ks.Shift&StateAlt != 0 {
+			mods = append(mods, VK_LMENU)
+		}
+		if ks.Shift&StateShift != 0 {
+			mods = append(mods, VK_LSHIFT)
+		}
+
+		for _, vk := range mods {
+			press(vk, false)
+		}
+		tap(ks.Vk, ks.Scan, ks.Extended)
+		for i := len(mods) - 1; i >= 0; i-- {
+			press(mods[i], true)
+		}
+
+		if ks.Dead {
+			scan, _, _ := l.ScanCode(VK_SPACE)
+			tap(VK_SPACE, scan, false)
+		}
+		if ks.Shift&StateKana != 0 {
+			scan, _, _ := l.ScanCode(VK_KANA)
+			tap(VK_KANA, scan, false)
+		}
+	}
+
+	return events, nil
+}
+
+// parseLayout parses the layout file data of tag. Each line is a directive,
+// "klid" with the [KLID] of the layout or "shift" with the [ShiftState] of each
+// column, or a key: its scan code in hexadecimal, with an E0 prefix if it is
+// extended, its virtual key in hexadecimal, and a column per shift state. A
+// column holds the character typed, or U+XXXX, followed by "*" for a dead key,
+// or "-" for none. A "#" starts a comment.
+//
+// The keys of common are appended to those of the file, except those with a
+// scan code of the file.
+func parseLayout(tag string, data []byte, common []layoutKey) (*KeyboardLayout, error) {
+	l := &KeyboardLayout{Tag: tag, chars: make(map[rune]KeyStroke)}
+
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	for n := 1; sc.Scan(); n++ {
+		line, _, _ := strings.Cut(sc.Text(), "#")
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		fail := func(format string, args ...any) error {
+			return fmt.Errorf("winapi: layout %s:%d: %s", tag, n, fmt.Sprintf(format, args...))
+		}
+
+		switch fields[0] {
+		case "klid":
+			if len(fields) != 2 {
+				return nil, fail("want klid XXXXXXXX")
+			}
+			klid, err := ParseKLID(fields[1])
+			if err != nil {
+				return nil, fail("%v", err)
+			}
+			l.KLID = klid
+			continue
+		case "shift":
+			for _, f := range fields[1:] {
+				s, err := strconv.ParseUint(f, 10, 8)
+				if err != nil {
+					return nil, fail("invalid shift state %q", f)
+				}
+				l.shifts = append(l.shifts, ShiftState(s))
+			}
+			continue
+		}
+
+		if len(fields) != 2+len(l.shifts) {
+			return nil, fail("want scan code, virtual key and %d characters", len(l.shifts))
+		}
+
+		var k layoutKey
+		scan := fields[0]
+		if len(scan) == 4 && strings.HasPrefix(strings.ToUpper(scan), "E0") {
+			k.extended, scan = true, scan[2:]
+		}
+		v, err := strconv.ParseUint(scan, 16, 8)
+		if err != nil || len(scan) != 2 {
+			return nil, fail("invalid scan code %q", fields[0])
+		}
+		k.scan = uint16(v)
+		if v, err = strconv.ParseUint(fields[1], 16, 8); err != nil || len(fields[1]) != 2 {
+			return nil, fail("invalid virtual key %q", fields[1])
+		}
+		k.vk = uint16(v)
+
+		for _, f := range fields[2:] {
+			r, dead, err := parseLayoutChar(f)
+			if err != nil {
+				return nil, fail("%v", err)
+			}
+			k.chars = append(k.chars, r)
+			k.dead = append(k.dead, dead)
+		}
+		l.keys = append(l.keys, k)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, c := range common {
+		if _, ok := l.VirtualKey(c.scan, c.extended); !ok {
+			l.keys = append(l.keys, layoutKey{
+				scan:     c.scan,
+				extended: c.extended,
+				vk:       c.vk,
+				chars:    make([]rune, len(l.shifts)),
+				dead:     make([]bool, len(l.shifts)),
+			})
+		}
+	}
+
+	// The first key of the first shift state wins, so that a character is
+	// typed with the fewest modifiers.
+	for i, s := range l.shifts {
+		for _, k := range l.keys {
+			if r := k.chars[i]; r != 0 {
+				if _, ok := l.chars[r]; !ok {
+					l.chars[r] = KeyStroke{k.vk, k.scan, k.extended, s, k.dead[i]}
+				}
+			}
+		}
+	}
+
+	return l, nil
+}
+
+// parseLayoutChar parses a character column of a layout file.
+func parseLayoutChar(f string) (r rune, dead bool, err error) {
+	if f == "-" {
+		return 0, false, nil
+	}
+
+	if len(f) > 1 && strings.HasSuffix(f, "*") {
+		f, dead = f[:len(f)-1], true
+	}
+
+	if len(f) > 2 && strings.HasPrefix(f, "U+") {
+		v, err := strconv.ParseUint(f[2:], 16, 32)
+		if err != nil || !utf8.ValidRune(rune(v)) {
+			return 0, false, fmt.Errorf("invalid character %q", f)
+		}
+		return rune(v), dead, nil
+	}
+
+	r, size := utf8.DecodeRuneInString(f)
+	if r == utf8.RuneError || size != len(f) {
+		return 0, false, fmt.Errorf("invalid character %q", f)
+	}
+
+	return r, dead, nil
+}
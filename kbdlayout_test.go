@@ -0,0 +1,218 @@
+package winapi_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/kamaranl/gotools/test"
+	"github.com/kamaranl/winapi"
+)
+
+func TestBuiltinLayout(t *testing.T) {
+	tName := "BuiltinLayout"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	want := []string{"de-DE", "en-GB", "en-US", "es-ES", "fr-FR", "ja-JP"}
+	if got := winapi.BuiltinLayouts(); !reflect.DeepEqual(got, want) {
+		t.Errorf(test.ErrWantFGotF, want, got)
+	}
+
+	scenes := []test.Scene{
+		{Input: "de-DE", Output: "de-DE 00000407", Passing: true},
+		{Input: "EN_gb", Output: "en-GB 00000809", Passing: true},
+		{Input: "en", Output: "en-US 00000409", Passing: true},
+		{Input: "es", Output: "es-ES 0000040A", Passing: true},
+		{Input: "ja", Output: "ja-JP 00000411", Passing: true},
+		{Input: "de-AT", Passing: false},
+		{Input: "xx", Passing: false},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			l, ok := winapi.BuiltinLayout(s.Input.(string))
+			if ok != s.Passing {
+				t.Fatalf(test.ErrWantFGotF, s.Passing, ok)
+			}
+			if ok {
+				if got := fmt.Sprintf("%s %v", l.Tag, l.KLID); got != s.Output {
+					t.Errorf(test.ErrWantFGotF, s.Output, got)
+				}
+			}
+		})
+	}
+}
+
+func TestLayoutKeyStroke(t *testing.T) {
+	tName := "LayoutKeyStroke"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	type stroke struct {
+		tag string
+		r   rune
+	}
+
+	scenes := []test.Scene{
+		{Input: stroke{"en-US", 'a'}, Output: winapi.KeyStroke{Vk: 'A', Scan: 0x1E}, Passing: true},
+		{Input: stroke{"en-US", '?'}, Output: winapi.KeyStroke{Vk: winapi.VK_OEM_2, Scan: 0x35, Shift: winapi.StateShift}, Passing: true},
+		{Input: stroke{"en-US", '\\'}, Output: winapi.KeyStroke{Vk: winapi.VK_OEM_5, Scan: 0x2B}, Passing: true},
+		{Input: stroke{"en-US", '-'}, Output: winapi.KeyStroke{Vk: winapi.VK_OEM_MINUS, Scan: 0x0C}, Passing: true},
+		{Input: stroke{"en-US", ' '}, Output: winapi.KeyStroke{Vk: winapi.VK_SPACE, Scan: 0x39}, Passing: true},
+		{Input: stroke{"en-US", '\n'}, Output: winapi.KeyStroke{Vk: winapi.VK_RETURN, Scan: 0x1C}, Passing: true},
+		{Input: stroke{"en-US", 'é'}, Passing: false},
+		{Input: stroke{"en-GB", '£'}, Output: winapi.KeyStroke{Vk: '3', Scan: 0x04, Shift: winapi.StateShift}, Passing: true},
+		{Input: stroke{"en-GB", 'É'}, Output: winapi.KeyStroke{Vk: 'E', Scan: 0x12, Shift: winapi.StateAltGr | winapi.StateShift}, Passing: true},
+		{Input: stroke{"de-DE", 'ö'}, Output: winapi.KeyStroke{Vk: winapi.VK_OEM_3, Scan: 0x27}, Passing: true},
+		{Input: stroke{"de-DE", 'z'}, Output: winapi.KeyStroke{Vk: 'Z', Scan: 0x15}, Passing: true},
+		{Input: stroke{"de-DE", '@'}, Output: winapi.KeyStroke{Vk: 'Q', Scan: 0x10, Shift: winapi.StateAltGr}, Passing: true},
+		{Input: stroke{"de-DE", '#'}, Output: winapi.KeyStroke{Vk: winapi.VK_OEM_2, Scan: 0x2B}, Passing: true},
+		{Input: stroke{"de-DE", '^'}, Output: winapi.KeyStroke{Vk: winapi.VK_OEM_5, Scan: 0x29, Dead: true}, Passing: true},
+		{Input: stroke{"de-DE", 'ê'}, Passing: false},
+		{Input: stroke{"fr-FR", 'a'}, Output: winapi.KeyStroke{Vk: 'A', Scan: 0x10}, Passing: true},
+		{Input: stroke{"fr-FR", '1'}, Output: winapi.KeyStroke{Vk: '1', Scan: 0x02, Shift: winapi.StateShift}, Passing: true},
+		{Input: stroke{"es-ES", 'ñ'}, Output: winapi.KeyStroke{Vk: winapi.VK_OEM_3, Scan: 0x27}, Passing: true},
+		{Input: stroke{"ja-JP", '@'}, Output: winapi.KeyStroke{Vk: winapi.VK_OEM_3, Scan: 0x1A}, Passing: true},
+		{Input: stroke{"ja-JP", 'ｱ'}, Output: winapi.KeyStroke{Vk: '3', Scan: 0x04, Shift: winapi.StateKana}, Passing: true},
+		{Input: stroke{"ja-JP", 'ｧ'}, Output: winapi.KeyStroke{Vk: '3', Scan: 0x04, Shift: winapi.StateKana | winapi.StateShift}, Passing: true},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			in := s.Input.(stroke)
+			l, _ := winapi.BuiltinLayout(in.tag)
+
+			ks, ok := l.KeyStroke(in.r)
+			if ok != s.Passing {
+				t.Fatalf(test.ErrWantFGotF, s.Passing, ok)
+			}
+			if !ok {
+				return
+			}
+			if ks != s.Output.(winapi.KeyStroke) {
+				t.Errorf(test.ErrWantFGotF, s.Output, ks)
+			}
+
+			// The key types the character back.
+			if in.r >= ' ' {
+				r, dead, ok := l.Char(ks.Vk, ks.Shift)
+				if !ok || r != in.r || dead != ks.Dead {
+					t.Errorf(test.ErrWantFGotF, in.r, r)
+				}
+			}
+		})
+	}
+}
+
+func TestLayoutScanCode(t *testing.T) {
+	tName := "LayoutScanCode"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	type key struct {
+		vk       uint16
+		scan     uint16
+		extended bool
+	}
+
+	scenes := []test.Scene{
+		{Input: key{winapi.VK_RETURN, 0x1C, false}, Output: true},
+		{Input: key{winapi.VK_RCONTROL, 0x1D, true}, Output: true},
+		{Input: key{winapi.VK_RMENU, 0x38, true}, Output: true},
+		{Input: key{winapi.VK_LMENU, 0x38, false}, Output: true},
+		{Input: key{winapi.VK_LEFT, 0x4B, true}, Output: true},
+		{Input: key{winapi.VK_NUMPAD4, 0x4B, false}, Output: true},
+		{Input: key{winapi.VK_DIVIDE, 0x35, true}, Output: true},
+		{Input: key{winapi.VK_NUMLOCK, 0x45, true}, Output: true},
+		{Input: key{winapi.VK_F12, 0x58, false}, Output: true},
+		{Input: key{winapi.VK_RETURN, 0x1C, true}, Output: false}, // numpad Enter
+	}
+
+	for _, tag := range winapi.BuiltinLayouts() {
+		l, _ := winapi.BuiltinLayout(tag)
+		for i, s := range scenes {
+			t.Run(fmt.Sprintf(tName+" %s #%d", tag, i), func(t *testing.T) {
+				k := s.Input.(key)
+
+				vk, ok := l.VirtualKey(k.scan, k.extended)
+				if !ok || vk != k.vk {
+					t.Errorf(test.ErrWantFGotF, k.vk, vk)
+				}
+				if !s.Output.(bool) {
+					return
+				}
+				scan, ext, ok := l.ScanCode(k.vk)
+				if !ok || scan != k.scan || ext != k.extended {
+					t.Errorf(test.ErrWantFGotF, k, key{vk, scan, ext})
+				}
+			})
+		}
+	}
+}
+
+func TestLayoutTypeEvents(t *testing.T) {
+	tName := "LayoutTypeEvents"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	scenes := []test.Scene{
+		{
+			Input: []string{"en-US", "Hi\r\n"},
+			Output: []string{
+				"key down LShift 2A", "key down H 23", "key up H 23", "key up LShift 2A",
+				"key down I 17", "key up I 17",
+				"key down Enter 1C", "key up Enter 1C",
+			},
+			Passing: true,
+		},
+		{
+			Input: []string{"de-DE", "@^"},
+			Output: []string{
+				"key down LCtrl 1D", "key down LAlt 38", "key down Q 10", "key up Q 10", "key up LAlt 38", "key up LCtrl 1D",
+				"key down Backslash 29", "key up Backslash 29", "key down Space 39", "key up Space 39",
+			},
+			Passing: true,
+		},
+		{
+			Input: []string{"ja-JP", "ｧ"},
+			Output: []string{
+				"key down 0x15 70", "key up 0x15 70",
+				"key down LShift 2A", "key down 3 04", "key up 3 04", "key up LShift 2A",
+				"key down 0x15 70", "key up 0x15 70",
+			},
+			Passing: true,
+		},
+		{Input: []string{"en-US", "naïve"}, Passing: false},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			in := s.Input.([]string)
+			l, _ := winapi.BuiltinLayout(in[0])
+
+			events, err := l.TypeEvents(in[1])
+			if !s.Passing {
+				if err == nil {
+					t.Errorf(test.ErrWantFGotF, "error", events)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf(test.ErrUnexpectedF, err)
+			}
+
+			var got []string
+			for _, e := range events {
+				got = append(got, keyString(e))
+			}
+			if !reflect.DeepEqual(got, s.Output) {
+				t.Errorf(test.ErrWantFGotF, s.Output, got)
+			}
+		})
+	}
+}
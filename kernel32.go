@@ -4,15 +4,18 @@ package winapi
 
 import (
 	"syscall"
+	"unsafe"
 )
 
 var (
-	kernel32             = syscall.NewLazyDLL("kernel32.dll")
-	procAttachConsole    = kernel32.NewProc("AttachConsole")
-	procAllocConsole     = kernel32.NewProc("AllocConsole")
-	procFreeConsole      = kernel32.NewProc("FreeConsole")
-	procGetConsoleWindow = kernel32.NewProc("GetConsoleWindow")
-	procSetStdHandle     = kernel32.NewProc("SetStdHandle")
+	kernel32                   = syscall.NewLazyDLL("kernel32.dll")
+	procAttachConsole          = kernel32.NewProc("AttachConsole")
+	procAllocConsole           = kernel32.NewProc("AllocConsole")
+	procCreateWaitableTimerExW = kernel32.NewProc("CreateWaitableTimerExW")
+	procFreeConsole            = kernel32.NewProc("FreeConsole")
+	procGetConsoleWindow       = kernel32.NewProc("GetConsoleWindow")
+	procSetStdHandle           = kernel32.NewProc("SetStdHandle")
+	procSetWaitableTimer       = kernel32.NewProc("SetWaitableTimer")
 )
 
 // AllocConsole creates a new console for the calling process.
@@ -48,6 +51,38 @@ func AttachConsole(pid ACPId) error {
 	return nil
 }
 
+// CreateWaitableTimerExW creates or opens a waitable timer object, which is
+// unnamed if name is empty. With CREATE_WAITABLE_TIMER_HIGH_RESOLUTION, the
+// timer is not bound to the system timer resolution.
+// It returns 0 with an error if the call fails, or a [Handle] to the timer with
+// no error on success.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/synchapi/nf-synchapi-createwaitabletimerexw
+//
+// Experimental: CreateWaitableTimerExW has not been tested or used internally.
+func CreateWaitableTimerExW(name string, flags CWTFlag, access uint32) (Handle, error) {
+	p, err := optUTF16Ptr(name)
+	if err != nil {
+		return 0, err
+	}
+
+	r1, _, err := procCreateWaitableTimerExW.Call(
+		0,
+		uintptr(unsafe.Pointer(p)),
+		uintptr(flags),
+		uintptr(access),
+	)
+	if r1 == 0 {
+		if err != syscall.Errno(0) {
+			return 0, err
+		}
+
+		return 0, syscall.EINVAL
+	}
+
+	return Handle(r1), nil
+}
+
 // FreeConsole detaches the calling process from its console.
 // It returns an error if the call fails.
 //
@@ -94,3 +129,31 @@ func SetStdHandle(stdHndl HSTDIO, fd uintptr) error {
 
 	return nil
 }
+
+// SetWaitableTimer activates the waitable timer, which is signaled at dueTime:
+// in 100-nanosecond intervals, relative to now if negative or as a FILETIME
+// if positive. If period is nonzero, the timer is then signaled every period
+// ms.
+// It returns an error if the call fails.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/synchapi/nf-synchapi-setwaitabletimer
+//
+// Experimental: SetWaitableTimer has not been tested or used internally.
+func SetWaitableTimer(timer Handle, dueTime int64, period int32) error {
+	if r1, _, err := procSetWaitableTimer.Call(
+		uintptr(timer),
+		uintptr(unsafe.Pointer(&dueTime)),
+		uintptr(period),
+		0,
+		0,
+		0,
+	); r1 == 0 {
+		if err != syscall.Errno(0) {
+			return err
+		}
+
+		return syscall.EINVAL
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package winapi
+
+import (
+	"strings"
+)
+
+// MOD represents a set of modifier keys, as taken by RegisterHotKey.
+type MOD uint32
+
+// [MOD] constants.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-registerhotkey
+const (
+	MOD_ALT      MOD = 0x0001
+	MOD_CONTROL  MOD = 0x0002
+	MOD_SHIFT    MOD = 0x0004
+	MOD_WIN      MOD = 0x0008
+	MOD_NOREPEAT MOD = 0x4000
+)
+
+// String returns the modifiers of m joined by "+" in the usual order of a
+// shortcut, such as "Ctrl+Alt+Shift", or an empty string if m has none.
+func (m MOD) String() string {
+	var names []string
+	for _, mod := range []struct {
+		mod  MOD
+		name string
+	}{
+		{MOD_CONTROL, "Ctrl"},
+		{MOD_ALT, "Alt"},
+		{MOD_SHIFT, "Shift"},
+		{MOD_WIN, "Win"},
+	} {
+		if m&mod.mod != 0 {
+			names = append(names, mod.name)
+		}
+	}
+
+	return strings.Join(names, "+")
+}
+
+// A KeyboardState is a snapshot of the status of the 256 virtual keys, in the
+// layout of GetKeyboardState and [ToUnicodeEx]: the high bit of an entry is set
+// if the key is down, and the low bit if it is toggled on. Pass it to those
+// functions as (*[256]byte)(s).
+type KeyboardState [256]byte
+
+// IsDown reports whether the key vk is down in s.
+func (s *KeyboardState) IsDown(vk uint16) bool {
+	return vk < uint16(len(s)) && s[vk]&0x80 != 0
+}
+
+// IsToggled reports whether the key vk, such as VK_CAPITAL, is toggled on in
+// s.
+func (s *KeyboardState) IsToggled(vk uint16) bool {
+	return vk < uint16(len(s)) && s[vk]&0x01 != 0
+}
+
+// Modifiers returns the modifier keys down in s, whether the generic key,
+// such as VK_SHIFT, or its left or right key is set.
+func (s *KeyboardState) Modifiers() MOD {
+	var m MOD
+	for _, mod := range []struct {
+		mod MOD
+		vks []uint16
+	}{
+		{MOD_SHIFT, []uint16{VK_SHIFT, VK_LSHIFT, VK_RSHIFT}},
+		{MOD_CONTROL, []uint16{VK_CONTROL, VK_LCONTROL, VK_RCONTROL}},
+		{MOD_ALT, []uint16{VK_MENU, VK_LMENU, VK_RMENU}},
+		{MOD_WIN, []uint16{VK_LWIN, VK_RWIN}},
+	} {
+		for _, vk := range mod.vks {
+			if s.IsDown(vk) {
+				m |= mod.mod
+			}
+		}
+	}
+
+	return m
+}
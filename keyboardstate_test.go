@@ -0,0 +1,55 @@
+package winapi_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/kamaranl/gotools/test"
+	"github.com/kamaranl/winapi"
+)
+
+func TestKeyboardState(t *testing.T) {
+	tName := "KeyboardState"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	type state struct {
+		down, toggled []uint16
+	}
+
+	scenes := []test.Scene{
+		{Input: state{}, Output: ""},
+		{Input: state{down: []uint16{winapi.VK_RCONTROL, 'A'}}, Output: "Ctrl"},
+		{Input: state{down: []uint16{winapi.VK_SHIFT, winapi.VK_LMENU, winapi.VK_RWIN}}, Output: "Alt+Shift+Win"},
+		{Input: state{toggled: []uint16{winapi.VK_LSHIFT, winapi.VK_NUMLOCK}}, Output: ""},
+		{Input: state{down: []uint16{winapi.VK_CONTROL, winapi.VK_LCONTROL, winapi.VK_RMENU}}, Output: "Ctrl+Alt"},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			in := s.Input.(state)
+
+			var ks winapi.KeyboardState
+			for _, vk := range in.down {
+				ks[vk] |= 0x80
+			}
+			for _, vk := range in.toggled {
+				ks[vk] |= 0x01
+			}
+
+			if got := ks.Modifiers().String(); got != s.Output {
+				t.Errorf(test.ErrWantFGotF, s.Output, got)
+			}
+			for vk := uint16(0); vk < 0x110; vk++ {
+				if got, want := ks.IsDown(vk), slices.Contains(in.down, vk); got != want {
+					t.Errorf("IsDown(%#x): "+test.ErrWantFGotF, vk, want, got)
+				}
+				if got, want := ks.IsToggled(vk), slices.Contains(in.toggled, vk); got != want {
+					t.Errorf("IsToggled(%#x): "+test.ErrWantFGotF, vk, want, got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+//go:build windows
+
+package winapi
+
+import (
+	"runtime"
+	"syscall"
+
+	"golang.org/x/sys/windows"
+)
+
+// CurrentKeyboardState returns the status of the 256 virtual keys as the
+// message queue of the calling thread last saw them, which lags behind the
+// keyboard while messages are waiting, as [GetKeyboardState] does.
+// It returns the error of [GetKeyboardState].
+//
+// Experimental: CurrentKeyboardState has not been tested or used internally.
+func CurrentKeyboardState() (*KeyboardState, error) {
+	var s KeyboardState
+	if err := GetKeyboardState((*[256]byte)(&s)); err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+// AsyncKeyboardState returns the physical status of the 256 virtual keys at
+// the time of the call, read with [GetAsyncKeyState] regardless of the message
+// queue of the calling thread. GetAsyncKeyState does not report toggle states,
+// so those of VK_CAPITAL, VK_NUMLOCK and VK_SCROLL are read as the thread of
+// the foreground window sees them.
+//
+// Experimental: AsyncKeyboardState has not been tested or used internally.
+func AsyncKeyboardState() *KeyboardState {
+	var s KeyboardState
+	for vk := range s {
+		if GetAsyncKeyState(byte(vk)) {
+			s[vk] |= 0x80
+		}
+	}
+	for _, vk := range []uint16{VK_CAPITAL, VK_NUMLOCK, VK_SCROLL} {
+		if toggled(vk) {
+			s[vk] |= 0x01
+		}
+	}
+
+	return &s
+}
+
+// SetToggle turns the toggle key vk, which is VK_CAPITAL, VK_NUMLOCK or
+// VK_SCROLL, on or off by pressing and releasing it, only if its state, as
+// the thread of the foreground window sees it, differs. The state changes once
+// the system has processed the key press.
+// It returns syscall.EINVAL if vk is not a toggle key, or the error of
+// [SendInputN].
+//
+// Experimental: SetToggle has not been tested or used internally.
+func SetToggle(vk uint16, on bool) error {
+	if vk != VK_CAPITAL && vk != VK_NUMLOCK && vk != VK_SCROLL {
+		return syscall.EINVAL
+	}
+
+	if toggled(vk) == on {
+		return nil
+	}
+
+	_, err := SendInputN([]INPUT{
+		NewInput(keyEvent(vk, false)),
+		NewInput(keyEvent(vk, true)),
+	}, 0)
+
+	return err
+}
+
+// toggled reports whether the toggle key vk is on. [GetKeyState] reports the
+// key state of the calling thread, which only follows the keyboard as the
+// thread reads input messages, so it is stale on threads without a message
+// loop. The calling thread therefore attaches its input to the thread of the
+// foreground window, whose key state it then shares, for the call.
+func toggled(vk uint16) bool {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	own := windows.GetCurrentThreadId()
+	if hwnd := windows.GetForegroundWindow(); hwnd != 0 {
+		if tid, err := windows.GetWindowThreadProcessId(hwnd, nil); err == nil && tid != own {
+			if err := AttachThreadInput(own, tid, true); err == nil {
+				defer AttachThreadInput(own, tid, false)
+			}
+		}
+	}
+
+	_, on := GetKeyState(byte(vk))
+
+	return on
+}
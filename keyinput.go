@@ -0,0 +1,147 @@
+package winapi
+
+// KeyMode selects how a [KeyEncoder] identifies keys in the events it builds.
+type KeyMode uint8
+
+// [KeyMode] constants.
+const (
+	// KeyModeVK sends the virtual key along with its scan code, which suits
+	// most programs. It is the default.
+	KeyModeVK KeyMode = iota
+
+	// KeyModeScan sends the scan code alone with KEYEVENTF_SCANCODE, which
+	// programs that read the keyboard below the message queue, such as games
+	// using DirectInput, need. Keys without a scan code, and Pause, whose E1
+	// prefix SendInput cannot express, are sent by virtual key.
+	KeyModeScan
+)
+
+// A KeyEncoder builds key events with the scan code of the key filled in and
+// KEYEVENTF_EXTENDEDKEY set for extended keys, such as the arrows, Insert and
+// Delete, right Ctrl and Alt, and numpad Enter and Divide, which otherwise
+// arrive as their numeric keypad or left counterparts. The zero value encodes
+// in KeyModeVK with the layout of the calling thread.
+type KeyEncoder struct {
+	// Mode selects virtual keys or scan codes.
+	Mode KeyMode
+
+	// Layout, if set, maps virtual keys to scan codes with its tables rather
+	// than with the active layout of the calling thread, which is only
+	// available on Windows; elsewhere, en-US is used.
+	Layout *KeyboardLayout
+}
+
+// defaultKeyEncoder is the encoder of [KeyDown], [KeyUp], [ScanDown] and
+// [ScanUp].
+var defaultKeyEncoder KeyEncoder
+
+// KeyDown returns the down event of the virtual key vk, as encoded by the zero
+// [KeyEncoder].
+func KeyDown(vk uint16) INPUT {
+	return defaultKeyEncoder.KeyDown(vk)
+}
+
+// KeyUp returns the up event of the virtual key vk, as encoded by the zero
+// [KeyEncoder].
+func KeyUp(vk uint16) INPUT {
+	return defaultKeyEncoder.KeyUp(vk)
+}
+
+// ScanDown returns the down event of the key with the scan code sc, sent with
+// KEYEVENTF_SCANCODE. An extended key has an E0 prefix, such as 0xE04B for
+// Left.
+func ScanDown(sc uint16) INPUT {
+	return NewInput(scanEvent(sc, false))
+}
+
+// ScanUp returns the up event of the key with the scan code sc, like
+// [ScanDown].
+func ScanUp(sc uint16) INPUT {
+	return NewInput(scanEvent(sc, true))
+}
+
+// KeyDown returns the down event of the virtual key vk.
+func (e *KeyEncoder) KeyDown(vk uint16) INPUT {
+	return NewInput(e.event(vk, false))
+}
+
+// KeyUp returns the up event of the virtual key vk.
+func (e *KeyEncoder) KeyUp(vk uint16) INPUT {
+	return NewInput(e.event(vk, true))
+}
+
+// event returns the down or up event of the virtual key vk.
+func (e *KeyEncoder) event(vk uint16, up bool) KEYBDINPUT {
+	sc := e.ScanCode(vk)
+	if e.Mode == KeyModeScan && sc&0xFF != 0 && sc>>8 != 0xE1 {
+		return scanEvent(sc, up)
+	}
+
+	ki := keyEvent(vk, up)
+	ki.Scan = sc & 0xFF
+	if sc>>8 == 0xE0 {
+		ki.Flags |= KEYEVENTF_EXTENDEDKEY
+	}
+
+	return ki
+}
+
+// ScanCode returns the scan code of the virtual key vk, with an E0 prefix if
+// it is an extended key, as MapVirtualKeyExW returns with MAPVK_VK_TO_VSC_EX,
+// or 0 if vk has none. The generic modifiers, such as VK_SHIFT, map to their
+// left key.
+func (e *KeyEncoder) ScanCode(vk uint16) uint16 {
+	var sc uint16
+	if e.Layout != nil {
+		sc = e.Layout.prefixedScanCode(vk)
+	} else {
+		sc = mapScanCode(vk)
+	}
+
+	// MapVirtualKeyExW leaves out the prefix of some keys that need the
+	// extended flag, such as Num Lock.
+	if sc != 0 && sc>>8 == 0 && extendedVK(vk) {
+		sc |= 0xE000
+	}
+
+	return sc
+}
+
+// prefixedScanCode returns the scan code of vk in l with an E0 prefix if it is
+// an extended key, or 0 if vk is not in l.
+func (l *KeyboardLayout) prefixedScanCode(vk uint16) uint16 {
+	scan, ext, _ := l.ScanCode(genericVK(vk))
+	if ext {
+		scan |= 0xE000
+	}
+
+	return scan
+}
+
+// genericVK returns the left key of the generic modifier vk, or vk.
+func genericVK(vk uint16) uint16 {
+	switch vk {
+	case VK_SHIFT:
+		return VK_LSHIFT
+	case VK_CONTROL:
+		return VK_LCONTROL
+	case VK_MENU:
+		return VK_LMENU
+	}
+
+	return vk
+}
+
+// scanEvent returns the down or up event of the key with the scan code sc,
+// sent with KEYEVENTF_SCANCODE.
+func scanEvent(sc uint16, up bool) KEYBDINPUT {
+	ki := KEYBDINPUT{Scan: sc & 0xFF, Flags: KEYEVENTF_SCANCODE}
+	if sc>>8 == 0xE0 {
+		ki.Flags |= KEYEVENTF_EXTENDEDKEY
+	}
+	if up {
+		ki.Flags |= KEYEVENTF_KEYUP
+	}
+
+	return ki
+}
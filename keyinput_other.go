@@ -0,0 +1,11 @@
+//go:build !windows
+
+package winapi
+
+// mapScanCode returns the scan code of vk, with its E0 prefix, in the
+// built-in en-US layout, as there is no active layout to ask.
+func mapScanCode(vk uint16) uint16 {
+	l, _ := BuiltinLayout("en-US")
+
+	return l.prefixedScanCode(vk)
+}
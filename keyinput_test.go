@@ -0,0 +1,94 @@
+package winapi_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kamaranl/gotools/test"
+	"github.com/kamaranl/winapi"
+)
+
+func TestKeyEncoder(t *testing.T) {
+	tName := "KeyEncoder"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	type key struct {
+		mode winapi.KeyMode
+		vk   uint16
+		up   bool
+	}
+
+	scenes := []test.Scene{
+		{Input: key{winapi.KeyModeVK, winapi.VK_LEFT, false}, Output: "key down Left 4B extended"},
+		{Input: key{winapi.KeyModeVK, winapi.VK_NUMPAD4, false}, Output: "key down Num4 4B"},
+		{Input: key{winapi.KeyModeVK, winapi.VK_RCONTROL, true}, Output: "key up RCtrl 1D extended"},
+		{Input: key{winapi.KeyModeVK, winapi.VK_SHIFT, false}, Output: "key down Shift 2A"},
+		{Input: key{winapi.KeyModeVK, winapi.VK_DIVIDE, false}, Output: "key down NumDivide 35 extended"},
+		{Input: key{winapi.KeyModeVK, winapi.VK_PAUSE, false}, Output: "key down Pause 00"},
+		{Input: key{winapi.KeyModeVK, 'Z', false}, Output: "key down Z 15"},
+		{Input: key{winapi.KeyModeScan, winapi.VK_LEFT, false}, Output: "scan down 0x4B extended 4B extended"},
+		{Input: key{winapi.KeyModeScan, winapi.VK_RMENU, true}, Output: "scan up 0x38 extended 38 extended"},
+		{Input: key{winapi.KeyModeScan, winapi.VK_RETURN, false}, Output: "scan down 0x1C 1C"},
+		{Input: key{winapi.KeyModeScan, winapi.VK_NUMLOCK, false}, Output: "scan down 0x45 extended 45 extended"},
+		{Input: key{winapi.KeyModeScan, winapi.VK_PAUSE, false}, Output: "key down Pause 00"},
+	}
+
+	de, _ := winapi.BuiltinLayout("de-DE")
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			k := s.Input.(key)
+			enc := winapi.KeyEncoder{Mode: k.mode, Layout: de}
+
+			in := enc.KeyDown(k.vk)
+			if k.up {
+				in = enc.KeyUp(k.vk)
+			}
+			if got := keyString(in); got != s.Output {
+				t.Errorf(test.ErrWantFGotF, s.Output, got)
+			}
+		})
+	}
+}
+
+func TestScanDown(t *testing.T) {
+	tName := "ScanDown"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	scenes := []test.Scene{
+		{Input: uint16(0x1E), Output: "scan down 0x1E 1E"},
+		{Input: uint16(0xE04B), Output: "scan down 0x4B extended 4B extended"},
+		{Input: uint16(0xE01C), Output: "scan down 0x1C extended 1C extended"},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			sc := s.Input.(uint16)
+			if got := keyString(winapi.ScanDown(sc)); got != s.Output {
+				t.Errorf(test.ErrWantFGotF, s.Output, got)
+			}
+
+			up := winapi.ScanUp(sc)
+			if down := winapi.ScanDown(sc); *up.Ki() != (winapi.KEYBDINPUT{
+				Scan:  down.Ki().Scan,
+				Flags: down.Ki().Flags | winapi.KEYEVENTF_KEYUP,
+			}) {
+				t.Errorf(test.ErrWantFGotF, down, up)
+			}
+		})
+	}
+}
+
+// keyString returns in, its scan code and its extended flag.
+func keyString(in winapi.INPUT) string {
+	ki := in.Ki()
+	s := fmt.Sprintf("%v %02X", in, ki.Scan)
+	if ki.Flags&winapi.KEYEVENTF_EXTENDEDKEY != 0 {
+		s += " extended"
+	}
+
+	return s
+}
@@ -0,0 +1,11 @@
+//go:build windows
+
+package winapi
+
+// mapScanCode returns the scan code of vk, with its E0 or E1 prefix, in the
+// active layout of the calling thread.
+func mapScanCode(vk uint16) uint16 {
+	sc, _ := MapVirtualKeyExW(uint32(vk), MAPVK_VK_TO_VSC_EX, Handle(GetKeyboardLayout(0)))
+
+	return uint16(sc)
+}
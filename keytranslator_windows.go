@@ -0,0 +1,167 @@
+//go:build windows
+
+package winapi
+
+import (
+	"runtime"
+	"strings"
+	"sync"
+	"unicode"
+
+	"golang.org/x/sys/windows"
+)
+
+// toUnicodeNoState is the flag of [ToUnicodeEx] that leaves the keyboard
+// state of the calling thread unchanged.
+const toUnicodeNoState = 0x4
+
+// A KeyTranslator turns key presses into the text they type with a given
+// layout and keyboard state, as TranslateMessage does for WM_KEYDOWN. It keeps
+// the dead key pressed last itself, and combines it with the next key, so that
+// translating never changes the dead-key buffer of the calling thread; a
+// thread that translates its own messages is not disturbed. The zero value is
+// ready to use, and a KeyTranslator is safe for concurrent use.
+//
+// Translating without changing the calling thread requires Windows 10,
+// version 1607 or later; earlier versions store dead keys in it regardless.
+type KeyTranslator struct {
+	mu   sync.Mutex
+	dead *deadKey
+}
+
+// A deadKey is a dead key waiting for the key it combines with.
+type deadKey struct {
+	vk, scan uint32
+	state    [256]byte
+	hkl      HKL
+	text     string
+}
+
+// Translate returns the text typed by pressing the key vk, with scan code
+// scan, in the keyboard state state and the layout hkl, as [GetKeyboardState]
+// and [LayoutForWindow] return them. Key releases, whose scan code has bit 15
+// set, are not translated and leave a dead key waiting.
+//
+// A dead key returns dead set to true and no text; it combines with the next
+// key translated with the same layout, such as "^" and "e" typing "ê", or
+// precedes it if they do not combine, such as "^" and "x" typing "^x". Keys
+// that type nothing, such as Shift, leave a dead key waiting.
+//
+// Experimental: Translate has not been tested or used internally.
+func (t *KeyTranslator) Translate(vk, scan uint32, state *[256]byte, hkl HKL) (text string, dead bool) {
+	if scan&0x8000 != 0 {
+		return "", false
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.dead != nil && t.dead.hkl != hkl {
+		t.dead = nil
+	}
+
+	var buf [16]uint16
+	n := ToUnicodeEx(vk, scan&0xFF, state, buf[:], toUnicodeNoState, hkl)
+	switch {
+	case n == 0:
+		return "", false
+	case t.dead == nil && n < 0:
+		t.dead = &deadKey{vk: vk, scan: scan & 0xFF, state: *state, hkl: hkl, text: windows.UTF16ToString(buf[:1])}
+		return "", true
+	case t.dead == nil:
+		return windows.UTF16ToString(buf[:min(int(n), len(buf))]), false
+	}
+
+	d := t.dead
+	t.dead = nil
+	n = combineDeadKey(d, vk, scan&0xFF, state, buf[:])
+	if n < 0 {
+		t.dead = &deadKey{vk: vk, scan: scan & 0xFF, state: *state, hkl: hkl, text: windows.UTF16ToString(buf[:1])}
+		return "", true
+	}
+
+	return windows.UTF16ToString(buf[:min(int(n), len(buf))]), false
+}
+
+// Pending returns the spacing character of the dead key waiting to combine
+// with the next key, such as "^", or an empty string if there is none.
+//
+// Experimental: Pending has not been tested or used internally.
+func (t *KeyTranslator) Pending() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.dead == nil {
+		return ""
+	}
+
+	return t.dead.text
+}
+
+// Reset drops the dead key waiting to combine with the next key, as when the
+// focus moves to another window.
+//
+// Experimental: Reset has not been tested or used internally.
+func (t *KeyTranslator) Reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.dead = nil
+}
+
+// combineDeadKey translates the dead key d followed by the key vk into buf,
+// and returns the result of [ToUnicodeEx] for vk.
+//
+// Combining needs the dead-key buffer of the layout, so it runs on a thread
+// of its own: the goroutine exits with its thread still locked, which makes
+// the runtime end the thread along with the dead key it may hold.
+func combineDeadKey(d *deadKey, vk, scan uint32, state *[256]byte, buf []uint16) int32 {
+	done := make(chan int32)
+	go func() {
+		runtime.LockOSThread()
+
+		ToUnicodeEx(d.vk, d.scan, &d.state, buf, 0, d.hkl)
+		done <- ToUnicodeEx(vk, scan, state, buf, 0, d.hkl)
+	}()
+
+	return <-done
+}
+
+// KeyName returns the name of the key vk for display in a shortcut, such as
+// "Ö" for VK_OEM_3 on a German layout. A key that types a character without
+// modifiers, including a dead key, is named by that character in upper case,
+// as on its keycap, with the layout hkl. Other keys, and the keys of the
+// numeric keypad, are named by [GetKeyNameTextW] in the language of the
+// layout of the calling thread, such as "Strg" or "Ctrl".
+// It returns the name of the package for vk, such as "LCtrl" or "0xE8", if the
+// key has no name.
+//
+// Experimental: KeyName has not been tested or used internally.
+func KeyName(vk uint16, hkl HKL) string {
+	scan, _ := MapVirtualKeyExW(uint32(vk), MAPVK_VK_TO_VSC_EX, Handle(hkl))
+
+	if vk < VK_NUMPAD0 || vk > VK_DIVIDE {
+		var (
+			state [256]byte
+			buf   [16]uint16
+		)
+		if n := ToUnicodeEx(uint32(vk), scan&0xFF, &state, buf[:], toUnicodeNoState, hkl); n != 0 {
+			text := windows.UTF16ToString(buf[:min(max(int(n), 1), len(buf))])
+			if text != "" && strings.IndexFunc(text, func(r rune) bool {
+				return !unicode.IsGraphic(r) || unicode.IsSpace(r)
+			}) < 0 {
+				return strings.ToUpper(text)
+			}
+		}
+	}
+
+	lParam := int32(scan&0xFF) << 16
+	if scan>>8 == 0xE0 || extendedVK(vk) {
+		lParam |= 1 << 24
+	}
+	if name, err := GetKeyNameTextW(lParam); err == nil && name != "" {
+		return name
+	}
+
+	return vkName(vk)
+}
@@ -0,0 +1,77 @@
+//go:build windows
+
+package winapi_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/kamaranl/gotools/test"
+	"github.com/kamaranl/winapi"
+)
+
+// TestRealKeyTranslator translates dead-key sequences with the German layout,
+// which is loaded for the test and unloaded afterwards unless it was already
+// loaded.
+func TestRealKeyTranslator(t *testing.T) {
+	tName := "KeyTranslator"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	loaded, err := winapi.GetKeyboardLayoutList()
+	if err != nil {
+		t.Fatalf(test.ErrUnexpectedF, err)
+	}
+	hkl, err := winapi.LoadKeyboardLayoutW(0x00000407, winapi.KLF_NOTELLSHELL)
+	if err != nil {
+		t.Fatalf(test.ErrUnexpectedF, err)
+	}
+	if !slices.Contains(loaded, hkl) {
+		defer winapi.UnloadKeyboardLayout(hkl)
+	}
+
+	type key struct {
+		vk, scan uint32
+	}
+	var (
+		circumflex = key{winapi.VK_OEM_5, 0x29}
+		e          = key{'E', 0x12}
+		x          = key{'X', 0x2D}
+	)
+
+	scenes := []test.Scene{
+		{Input: []key{circumflex, e}, Output: "ê"},
+		{Input: []key{circumflex, x}, Output: "^x"},
+		{Input: []key{e}, Output: "e"},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			var (
+				tr    winapi.KeyTranslator
+				state [256]byte
+				got   string
+			)
+			for _, k := range s.Input.([]key) {
+				text, _ := tr.Translate(k.vk, k.scan, &state, hkl)
+
+				// The release must neither type anything nor consume a
+				// waiting dead key.
+				if up, _ := tr.Translate(k.vk, k.scan|0x8000, &state, hkl); up != "" {
+					t.Errorf(test.ErrUnexpectedF, up)
+				}
+				got += text
+			}
+
+			if got != s.Output.(string) {
+				t.Errorf(test.ErrWantFGotF, s.Output, got)
+			}
+		})
+	}
+
+	if got := winapi.KeyName(winapi.VK_OEM_3, hkl); got != "Ö" {
+		t.Errorf(test.ErrWantFGotF, "Ö", got)
+	}
+}
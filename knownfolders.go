@@ -0,0 +1,344 @@
+package winapi
+
+import "strings"
+
+// A KNOWNFOLDERID is a [GUID] that identifies a standard folder registered
+// with the system as a known folder.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/shell/knownfolderid
+type KNOWNFOLDERID GUID
+
+// String returns id in the registry format.
+func (id *KNOWNFOLDERID) String() string {
+	return GUID(*id).String()
+}
+
+// [KNOWNFOLDERID] values.
+//
+// FOLDERID_SkyDrive is the legacy name of FOLDERID_OneDrive and shares its id.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/shell/knownfolderid
+var (
+	FOLDERID_NetworkFolder          = &KNOWNFOLDERID{0xD20BEEC4, 0x5CA8, 0x4905, [8]byte{0xAE, 0x3B, 0xBF, 0x25, 0x1E, 0xA0, 0x9B, 0x53}}
+	FOLDERID_ComputerFolder         = &KNOWNFOLDERID{0x0AC0837C, 0xBBF8, 0x452A, [8]byte{0x85, 0x0D, 0x79, 0xD0, 0x8E, 0x66, 0x7C, 0xA7}}
+	FOLDERID_InternetFolder         = &KNOWNFOLDERID{0x4D9F7874, 0x4E0C, 0x4904, [8]byte{0x96, 0x7B, 0x40, 0xB0, 0xD2, 0x0C, 0x3E, 0x4B}}
+	FOLDERID_ControlPanelFolder     = &KNOWNFOLDERID{0x82A74AEB, 0xAEB4, 0x465C, [8]byte{0xA0, 0x14, 0xD0, 0x97, 0xEE, 0x34, 0x6D, 0x63}}
+	FOLDERID_PrintersFolder         = &KNOWNFOLDERID{0x76FC4E2D, 0xD6AD, 0x4519, [8]byte{0xA6, 0x63, 0x37, 0xBD, 0x56, 0x06, 0x81, 0x85}}
+	FOLDERID_SyncManagerFolder      = &KNOWNFOLDERID{0x43668BF8, 0xC14E, 0x49B2, [8]byte{0x97, 0xC9, 0x74, 0x77, 0x84, 0xD7, 0x84, 0xB7}}
+	FOLDERID_SyncSetupFolder        = &KNOWNFOLDERID{0x0F214138, 0xB1D3, 0x4A90, [8]byte{0xBB, 0xA9, 0x27, 0xCB, 0xC0, 0xC5, 0x38, 0x9A}}
+	FOLDERID_ConflictFolder         = &KNOWNFOLDERID{0x4BFEFB45, 0x347D, 0x4006, [8]byte{0xA5, 0xBE, 0xAC, 0x0C, 0xB0, 0x56, 0x71, 0x92}}
+	FOLDERID_SyncResultsFolder      = &KNOWNFOLDERID{0x289A9A43, 0xBE44, 0x4057, [8]byte{0xA4, 0x1B, 0x58, 0x7A, 0x76, 0xD7, 0xE7, 0xF9}}
+	FOLDERID_RecycleBinFolder       = &KNOWNFOLDERID{0xB7534046, 0x3ECB, 0x4C18, [8]byte{0xBE, 0x4E, 0x64, 0xCD, 0x4C, 0xB7, 0xD6, 0xAC}}
+	FOLDERID_ConnectionsFolder      = &KNOWNFOLDERID{0x6F0CD92B, 0x2E97, 0x45D1, [8]byte{0x88, 0xFF, 0xB0, 0xD1, 0x86, 0xB8, 0xDE, 0xDD}}
+	FOLDERID_Fonts                  = &KNOWNFOLDERID{0xFD228CB7, 0xAE11, 0x4AE3, [8]byte{0x86, 0x4C, 0x16, 0xF3, 0x91, 0x0A, 0xB8, 0xFE}}
+	FOLDERID_Desktop                = &KNOWNFOLDERID{0xB4BFCC3A, 0xDB2C, 0x424C, [8]byte{0xB0, 0x29, 0x7F, 0xE9, 0x9A, 0x87, 0xC6, 0x41}}
+	FOLDERID_Startup                = &KNOWNFOLDERID{0xB97D20BB, 0xF46A, 0x4C97, [8]byte{0xBA, 0x10, 0x5E, 0x36, 0x08, 0x43, 0x08, 0x54}}
+	FOLDERID_Programs               = &KNOWNFOLDERID{0xA77F5D77, 0x2E2B, 0x44C3, [8]byte{0xA6, 0xA2, 0xAB, 0xA6, 0x01, 0x05, 0x4A, 0x51}}
+	FOLDERID_StartMenu              = &KNOWNFOLDERID{0x625B53C3, 0xAB48, 0x4EC1, [8]byte{0xBA, 0x1F, 0xA1, 0xEF, 0x41, 0x46, 0xFC, 0x19}}
+	FOLDERID_Recent                 = &KNOWNFOLDERID{0xAE50C081, 0xEBD2, 0x438A, [8]byte{0x86, 0x55, 0x8A, 0x09, 0x2E, 0x34, 0x98, 0x7A}}
+	FOLDERID_SendTo                 = &KNOWNFOLDERID{0x8983036C, 0x27C0, 0x404B, [8]byte{0x8F, 0x08, 0x10, 0x2D, 0x10, 0xDC, 0xFD, 0x74}}
+	FOLDERID_Documents              = &KNOWNFOLDERID{0xFDD39AD0, 0x238F, 0x46AF, [8]byte{0xAD, 0xB4, 0x6C, 0x85, 0x48, 0x03, 0x69, 0xC7}}
+	FOLDERID_Favorites              = &KNOWNFOLDERID{0x1777F761, 0x68AD, 0x4D8A, [8]byte{0x87, 0xBD, 0x30, 0xB7, 0x59, 0xFA, 0x33, 0xDD}}
+	FOLDERID_NetHood                = &KNOWNFOLDERID{0xC5ABBF53, 0xE17F, 0x4121, [8]byte{0x89, 0x00, 0x86, 0x62, 0x6F, 0xC2, 0xC9, 0x73}}
+	FOLDERID_PrintHood              = &KNOWNFOLDERID{0x9274BD8D, 0xCFD1, 0x41C3, [8]byte{0xB3, 0x5E, 0xB1, 0x3F, 0x55, 0xA7, 0x58, 0xF4}}
+	FOLDERID_Templates              = &KNOWNFOLDERID{0xA63293E8, 0x664E, 0x48DB, [8]byte{0xA0, 0x79, 0xDF, 0x75, 0x9E, 0x05, 0x09, 0xF7}}
+	FOLDERID_CommonStartup          = &KNOWNFOLDERID{0x82A5EA35, 0xD9CD, 0x47C5, [8]byte{0x96, 0x29, 0xE1, 0x5D, 0x2F, 0x71, 0x4E, 0x6E}}
+	FOLDERID_CommonPrograms         = &KNOWNFOLDERID{0x0139D44E, 0x6AFE, 0x49F2, [8]byte{0x86, 0x90, 0x3D, 0xAF, 0xCA, 0xE6, 0xFF, 0xB8}}
+	FOLDERID_CommonStartMenu        = &KNOWNFOLDERID{0xA4115719, 0xD62E, 0x491D, [8]byte{0xAA, 0x7C, 0xE7, 0x4B, 0x8B, 0xE3, 0xB0, 0x67}}
+	FOLDERID_PublicDesktop          = &KNOWNFOLDERID{0xC4AA340D, 0xF20F, 0x4863, [8]byte{0xAF, 0xEF, 0xF8, 0x7E, 0xF2, 0xE6, 0xBA, 0x25}}
+	FOLDERID_ProgramData            = &KNOWNFOLDERID{0x62AB5D82, 0xFDC1, 0x4DC3, [8]byte{0xA9, 0xDD, 0x07, 0x0D, 0x1D, 0x49, 0x5D, 0x97}}
+	FOLDERID_CommonTemplates        = &KNOWNFOLDERID{0xB94237E7, 0x57AC, 0x4347, [8]byte{0x91, 0x51, 0xB0, 0x8C, 0x6C, 0x32, 0xD1, 0xF7}}
+	FOLDERID_PublicDocuments        = &KNOWNFOLDERID{0xED4824AF, 0xDCE4, 0x45A8, [8]byte{0x81, 0xE2, 0xFC, 0x79, 0x65, 0x08, 0x36, 0x34}}
+	FOLDERID_RoamingAppData         = &KNOWNFOLDERID{0x3EB685DB, 0x65F9, 0x4CF6, [8]byte{0xA0, 0x3A, 0xE3, 0xEF, 0x65, 0x72, 0x9F, 0x3D}}
+	FOLDERID_LocalAppData           = &KNOWNFOLDERID{0xF1B32785, 0x6FBA, 0x4FCF, [8]byte{0x9D, 0x55, 0x7B, 0x8E, 0x7F, 0x15, 0x70, 0x91}}
+	FOLDERID_LocalAppDataLow        = &KNOWNFOLDERID{0xA520A1A4, 0x1780, 0x4FF6, [8]byte{0xBD, 0x18, 0x16, 0x73, 0x43, 0xC5, 0xAF, 0x16}}
+	FOLDERID_InternetCache          = &KNOWNFOLDERID{0x352481E8, 0x33BE, 0x4251, [8]byte{0xBA, 0x85, 0x60, 0x07, 0xCA, 0xED, 0xCF, 0x9D}}
+	FOLDERID_Cookies                = &KNOWNFOLDERID{0x2B0F765D, 0xC0E9, 0x4171, [8]byte{0x90, 0x8E, 0x08, 0xA6, 0x11, 0xB8, 0x4F, 0xF6}}
+	FOLDERID_History                = &KNOWNFOLDERID{0xD9DC8A3B, 0xB784, 0x432E, [8]byte{0xA7, 0x81, 0x5A, 0x11, 0x30, 0xA7, 0x59, 0x63}}
+	FOLDERID_System                 = &KNOWNFOLDERID{0x1AC14E77, 0x02E7, 0x4E5D, [8]byte{0xB7, 0x44, 0x2E, 0xB1, 0xAE, 0x51, 0x98, 0xB7}}
+	FOLDERID_SystemX86              = &KNOWNFOLDERID{0xD65231B0, 0xB2F1, 0x4857, [8]byte{0xA4, 0xCE, 0xA8, 0xE7, 0xC6, 0xEA, 0x7D, 0x27}}
+	FOLDERID_Windows                = &KNOWNFOLDERID{0xF38BF404, 0x1D43, 0x42F2, [8]byte{0x93, 0x05, 0x67, 0xDE, 0x0B, 0x28, 0xFC, 0x23}}
+	FOLDERID_Profile                = &KNOWNFOLDERID{0x5E6C858F, 0x0E22, 0x4760, [8]byte{0x9A, 0xFE, 0xEA, 0x33, 0x17, 0xB6, 0x71, 0x73}}
+	FOLDERID_Pictures               = &KNOWNFOLDERID{0x33E28130, 0x4E1E, 0x4676, [8]byte{0x83, 0x5A, 0x98, 0x39, 0x5C, 0x3B, 0xC3, 0xBB}}
+	FOLDERID_ProgramFilesX86        = &KNOWNFOLDERID{0x7C5A40EF, 0xA0FB, 0x4BFC, [8]byte{0x87, 0x4A, 0xC0, 0xF2, 0xE0, 0xB9, 0xFA, 0x8E}}
+	FOLDERID_ProgramFilesCommonX86  = &KNOWNFOLDERID{0xDE974D24, 0xD9C6, 0x4D3E, [8]byte{0xBF, 0x91, 0xF4, 0x45, 0x51, 0x20, 0xB9, 0x17}}
+	FOLDERID_ProgramFilesX64        = &KNOWNFOLDERID{0x6D809377, 0x6AF0, 0x444B, [8]byte{0x89, 0x57, 0xA3, 0x77, 0x3F, 0x02, 0x20, 0x0E}}
+	FOLDERID_ProgramFilesCommonX64  = &KNOWNFOLDERID{0x6365D5A7, 0x0F0D, 0x45E5, [8]byte{0x87, 0xF6, 0x0D, 0xA5, 0x6B, 0x6A, 0x4F, 0x7D}}
+	FOLDERID_ProgramFiles           = &KNOWNFOLDERID{0x905E63B6, 0xC1BF, 0x494E, [8]byte{0xB2, 0x9C, 0x65, 0xB7, 0x32, 0xD3, 0xD2, 0x1A}}
+	FOLDERID_ProgramFilesCommon     = &KNOWNFOLDERID{0xF7F1ED05, 0x9F6D, 0x47A2, [8]byte{0xAA, 0xAE, 0x29, 0xD3, 0x17, 0xC6, 0xF0, 0x66}}
+	FOLDERID_UserProgramFiles       = &KNOWNFOLDERID{0x5CD7AEE2, 0x2219, 0x4A67, [8]byte{0xB8, 0x5D, 0x6C, 0x9C, 0xE1, 0x56, 0x60, 0xCB}}
+	FOLDERID_UserProgramFilesCommon = &KNOWNFOLDERID{0xBCBD3057, 0xCA5C, 0x4622, [8]byte{0xB4, 0x2D, 0xBC, 0x56, 0xDB, 0x0A, 0xE5, 0x16}}
+	FOLDERID_AdminTools             = &KNOWNFOLDERID{0x724EF170, 0xA42D, 0x4FEF, [8]byte{0x9F, 0x26, 0xB6, 0x0E, 0x84, 0x6F, 0xBA, 0x4F}}
+	FOLDERID_CommonAdminTools       = &KNOWNFOLDERID{0xD0384E7D, 0xBAC3, 0x4797, [8]byte{0x8F, 0x14, 0xCB, 0xA2, 0x29, 0xB3, 0x92, 0xB5}}
+	FOLDERID_Music                  = &KNOWNFOLDERID{0x4BD8D571, 0x6D19, 0x48D3, [8]byte{0xBE, 0x97, 0x42, 0x22, 0x20, 0x08, 0x0E, 0x43}}
+	FOLDERID_Videos                 = &KNOWNFOLDERID{0x18989B1D, 0x99B5, 0x455B, [8]byte{0x84, 0x1C, 0xAB, 0x7C, 0x74, 0xE4, 0xDD, 0xFC}}
+	FOLDERID_Ringtones              = &KNOWNFOLDERID{0xC870044B, 0xF49E, 0x4126, [8]byte{0xA9, 0xC3, 0xB5, 0x2A, 0x1F, 0xF4, 0x11, 0xE8}}
+	FOLDERID_PublicPictures         = &KNOWNFOLDERID{0xB6EBFB86, 0x6907, 0x413C, [8]byte{0x9A, 0xF7, 0x4F, 0xC2, 0xAB, 0xF0, 0x7C, 0xC5}}
+	FOLDERID_PublicMusic            = &KNOWNFOLDERID{0x3214FAB5, 0x9757, 0x4298, [8]byte{0xBB, 0x61, 0x92, 0xA9, 0xDE, 0xAA, 0x44, 0xFF}}
+	FOLDERID_PublicVideos           = &KNOWNFOLDERID{0x2400183A, 0x6185, 0x49FB, [8]byte{0xA2, 0xD8, 0x4A, 0x39, 0x2A, 0x60, 0x2B, 0xA3}}
+	FOLDERID_PublicRingtones        = &KNOWNFOLDERID{0xE555AB60, 0x153B, 0x4D17, [8]byte{0x9F, 0x04, 0xA5, 0xFE, 0x99, 0xFC, 0x15, 0xEC}}
+	FOLDERID_ResourceDir            = &KNOWNFOLDERID{0x8AD10C31, 0x2ADB, 0x4296, [8]byte{0xA8, 0xF7, 0xE4, 0x70, 0x12, 0x32, 0xC9, 0x72}}
+	FOLDERID_LocalizedResourcesDir  = &KNOWNFOLDERID{0x2A00375E, 0x224C, 0x49DE, [8]byte{0xB8, 0xD1, 0x44, 0x0D, 0xF7, 0xEF, 0x3D, 0xDC}}
+	FOLDERID_CommonOEMLinks         = &KNOWNFOLDERID{0xC1BAE2D0, 0x10DF, 0x4334, [8]byte{0xBE, 0xDD, 0x7A, 0xA2, 0x0B, 0x22, 0x7A, 0x9D}}
+	FOLDERID_CDBurning              = &KNOWNFOLDERID{0x9E52AB10, 0xF80D, 0x49DF, [8]byte{0xAC, 0xB8, 0x43, 0x30, 0xF5, 0x68, 0x78, 0x55}}
+	FOLDERID_UserProfiles           = &KNOWNFOLDERID{0x0762D272, 0xC50A, 0x4BB0, [8]byte{0xA3, 0x82, 0x69, 0x7D, 0xCD, 0x72, 0x9B, 0x80}}
+	FOLDERID_Playlists              = &KNOWNFOLDERID{0xDE92C1C7, 0x837F, 0x4F69, [8]byte{0xA3, 0xBB, 0x86, 0xE6, 0x31, 0x20, 0x4A, 0x23}}
+	FOLDERID_SamplePlaylists        = &KNOWNFOLDERID{0x15CA69B3, 0x30EE, 0x49C1, [8]byte{0xAC, 0xE1, 0x6B, 0x5E, 0xC3, 0x72, 0xAF, 0xB5}}
+	FOLDERID_SampleMusic            = &KNOWNFOLDERID{0xB250C668, 0xF57D, 0x4EE1, [8]byte{0xA6, 0x3C, 0x29, 0x0E, 0xE7, 0xD1, 0xAA, 0x1F}}
+	FOLDERID_SamplePictures         = &KNOWNFOLDERID{0xC4900540, 0x2379, 0x4C75, [8]byte{0x84, 0x4B, 0x64, 0xE6, 0xFA, 0xF8, 0x71, 0x6B}}
+	FOLDERID_SampleVideos           = &KNOWNFOLDERID{0x859EAD94, 0x2E85, 0x48AD, [8]byte{0xA7, 0x1A, 0x09, 0x69, 0xCB, 0x56, 0xA6, 0xCD}}
+	FOLDERID_PhotoAlbums            = &KNOWNFOLDERID{0x69D2CF90, 0xFC33, 0x4FB7, [8]byte{0x9A, 0x0C, 0xEB, 0xB0, 0xF0, 0xFC, 0xB4, 0x3C}}
+	FOLDERID_Public                 = &KNOWNFOLDERID{0xDFDF76A2, 0xC82A, 0x4D63, [8]byte{0x90, 0x6A, 0x56, 0x44, 0xAC, 0x45, 0x73, 0x85}}
+	FOLDERID_ChangeRemovePrograms   = &KNOWNFOLDERID{0xDF7266AC, 0x9274, 0x4867, [8]byte{0x8D, 0x55, 0x3B, 0xD6, 0x61, 0xDE, 0x87, 0x2D}}
+	FOLDERID_AppUpdates             = &KNOWNFOLDERID{0xA305CE99, 0xF527, 0x492B, [8]byte{0x8B, 0x1A, 0x7E, 0x76, 0xFA, 0x98, 0xD6, 0xE4}}
+	FOLDERID_AddNewPrograms         = &KNOWNFOLDERID{0xDE61D971, 0x5EBC, 0x4F02, [8]byte{0xA3, 0xA9, 0x6C, 0x82, 0x89, 0x5E, 0x5C, 0x04}}
+	FOLDERID_Downloads              = &KNOWNFOLDERID{0x374DE290, 0x123F, 0x4565, [8]byte{0x91, 0x64, 0x39, 0xC4, 0x92, 0x5E, 0x46, 0x7B}}
+	FOLDERID_PublicDownloads        = &KNOWNFOLDERID{0x3D644C9B, 0x1FB8, 0x4F30, [8]byte{0x9B, 0x45, 0xF6, 0x70, 0x23, 0x5F, 0x79, 0xC0}}
+	FOLDERID_SavedSearches          = &KNOWNFOLDERID{0x7D1D3A04, 0xDEBB, 0x4115, [8]byte{0x95, 0xCF, 0x2F, 0x29, 0xDA, 0x29, 0x20, 0xDA}}
+	FOLDERID_QuickLaunch            = &KNOWNFOLDERID{0x52A4F021, 0x7B75, 0x48A9, [8]byte{0x9F, 0x6B, 0x4B, 0x87, 0xA2, 0x10, 0xBC, 0x8F}}
+	FOLDERID_Contacts               = &KNOWNFOLDERID{0x56784854, 0xC6CB, 0x462B, [8]byte{0x81, 0x69, 0x88, 0xE3, 0x50, 0xAC, 0xB8, 0x82}}
+	FOLDERID_SidebarParts           = &KNOWNFOLDERID{0xA75D362E, 0x50FC, 0x4FB7, [8]byte{0xAC, 0x2C, 0xA8, 0xBE, 0xAA, 0x31, 0x44, 0x93}}
+	FOLDERID_SidebarDefaultParts    = &KNOWNFOLDERID{0x7B396E54, 0x9EC5, 0x4300, [8]byte{0xBE, 0x0A, 0x24, 0x82, 0xEB, 0xAE, 0x1A, 0x26}}
+	FOLDERID_PublicGameTasks        = &KNOWNFOLDERID{0xDEBF2536, 0xE1A8, 0x4C59, [8]byte{0xB6, 0xA2, 0x41, 0x45, 0x86, 0x47, 0x6A, 0xEA}}
+	FOLDERID_GameTasks              = &KNOWNFOLDERID{0x054FAE61, 0x4DD8, 0x4787, [8]byte{0x80, 0xB6, 0x09, 0x02, 0x20, 0xC4, 0xB7, 0x00}}
+	FOLDERID_SavedGames             = &KNOWNFOLDERID{0x4C5C32FF, 0xBB9D, 0x43B0, [8]byte{0xB5, 0xB4, 0x2D, 0x72, 0xE5, 0x4E, 0xAA, 0xA4}}
+	FOLDERID_Games                  = &KNOWNFOLDERID{0xCAC52C1A, 0xB53D, 0x4EDC, [8]byte{0x92, 0xD7, 0x6B, 0x2E, 0x8A, 0xC1, 0x94, 0x34}}
+	FOLDERID_SEARCH_MAPI            = &KNOWNFOLDERID{0x98EC0E18, 0x2098, 0x4D44, [8]byte{0x86, 0x44, 0x66, 0x97, 0x93, 0x15, 0xA2, 0x81}}
+	FOLDERID_SEARCH_CSC             = &KNOWNFOLDERID{0xEE32E446, 0x31CA, 0x4ABA, [8]byte{0x81, 0x4F, 0xA5, 0xEB, 0xD2, 0xFD, 0x6D, 0x5E}}
+	FOLDERID_Links                  = &KNOWNFOLDERID{0xBFB9D5E0, 0xC6A9, 0x404C, [8]byte{0xB2, 0xB2, 0xAE, 0x6D, 0xB6, 0xAF, 0x49, 0x68}}
+	FOLDERID_UsersFiles             = &KNOWNFOLDERID{0xF3CE0F7C, 0x4901, 0x4ACC, [8]byte{0x86, 0x48, 0xD5, 0xD4, 0x4B, 0x04, 0xEF, 0x8F}}
+	FOLDERID_UsersLibraries         = &KNOWNFOLDERID{0xA302545D, 0xDEFF, 0x464B, [8]byte{0xAB, 0xE8, 0x61, 0xC8, 0x64, 0x8D, 0x93, 0x9B}}
+	FOLDERID_SearchHome             = &KNOWNFOLDERID{0x190337D1, 0xB8CA, 0x4121, [8]byte{0xA6, 0x39, 0x6D, 0x47, 0x2D, 0x16, 0x97, 0x2A}}
+	FOLDERID_OriginalImages         = &KNOWNFOLDERID{0x2C36C0AA, 0x5812, 0x4B87, [8]byte{0xBF, 0xD0, 0x4C, 0xD0, 0xDF, 0xB1, 0x9B, 0x39}}
+	FOLDERID_DocumentsLibrary       = &KNOWNFOLDERID{0x7B0DB17D, 0x9CD2, 0x4A93, [8]byte{0x97, 0x33, 0x46, 0xCC, 0x89, 0x02, 0x2E, 0x7C}}
+	FOLDERID_MusicLibrary           = &KNOWNFOLDERID{0x2112AB0A, 0xC86A, 0x4FFE, [8]byte{0xA3, 0x68, 0x0D, 0xE9, 0x6E, 0x47, 0x01, 0x2E}}
+	FOLDERID_PicturesLibrary        = &KNOWNFOLDERID{0xA990AE9F, 0xA03B, 0x4E80, [8]byte{0x94, 0xBC, 0x99, 0x12, 0xD7, 0x50, 0x41, 0x04}}
+	FOLDERID_VideosLibrary          = &KNOWNFOLDERID{0x491E922F, 0x5643, 0x4AF4, [8]byte{0xA7, 0xEB, 0x4E, 0x7A, 0x13, 0x8D, 0x81, 0x74}}
+	FOLDERID_RecordedTVLibrary      = &KNOWNFOLDERID{0x1A6FDBA2, 0xF42D, 0x4358, [8]byte{0xA7, 0x98, 0xB7, 0x4D, 0x74, 0x59, 0x26, 0xC5}}
+	FOLDERID_HomeGroup              = &KNOWNFOLDERID{0x52528A6B, 0xB9E3, 0x4ADD, [8]byte{0xB6, 0x0D, 0x58, 0x8C, 0x2D, 0xBA, 0x84, 0x2D}}
+	FOLDERID_HomeGroupCurrentUser   = &KNOWNFOLDERID{0x9B74B6A3, 0x0DFD, 0x4F11, [8]byte{0x9E, 0x78, 0x5F, 0x78, 0x00, 0xF2, 0xE7, 0x72}}
+	FOLDERID_DeviceMetadataStore    = &KNOWNFOLDERID{0x5CE4A5E9, 0xE4EB, 0x479D, [8]byte{0xB8, 0x9F, 0x13, 0x0C, 0x02, 0x88, 0x61, 0x55}}
+	FOLDERID_Libraries              = &KNOWNFOLDERID{0x1B3EA5DC, 0xB587, 0x4786, [8]byte{0xB4, 0xEF, 0xBD, 0x1D, 0xC3, 0x32, 0xAE, 0xAE}}
+	FOLDERID_PublicLibraries        = &KNOWNFOLDERID{0x48DAF80B, 0xE6CF, 0x4F4E, [8]byte{0xB8, 0x00, 0x0E, 0x69, 0xD8, 0x4E, 0xE3, 0x84}}
+	FOLDERID_UserPinned             = &KNOWNFOLDERID{0x9E3995AB, 0x1F9C, 0x4F13, [8]byte{0xB8, 0x27, 0x48, 0xB2, 0x4B, 0x6C, 0x71, 0x74}}
+	FOLDERID_ImplicitAppShortcuts   = &KNOWNFOLDERID{0xBCB5256F, 0x79F6, 0x4CEE, [8]byte{0xB7, 0x25, 0xDC, 0x34, 0xE4, 0x02, 0xFD, 0x46}}
+	FOLDERID_AccountPictures        = &KNOWNFOLDERID{0x008CA0B1, 0x55B4, 0x4C56, [8]byte{0xB8, 0xA8, 0x4D, 0xE4, 0xB2, 0x99, 0xD3, 0xBE}}
+	FOLDERID_PublicUserTiles        = &KNOWNFOLDERID{0x0482AF6C, 0x08F1, 0x4C34, [8]byte{0x8C, 0x90, 0xE1, 0x7E, 0xC9, 0x8B, 0x1E, 0x17}}
+	FOLDERID_AppsFolder             = &KNOWNFOLDERID{0x1E87508D, 0x89C2, 0x42F0, [8]byte{0x8A, 0x7E, 0x64, 0x5A, 0x0F, 0x50, 0xCA, 0x58}}
+	FOLDERID_StartMenuAllPrograms   = &KNOWNFOLDERID{0xF26305EF, 0x6948, 0x40B9, [8]byte{0xB2, 0x55, 0x81, 0x45, 0x3D, 0x09, 0xC7, 0x85}}
+	FOLDERID_CommonStartMenuPlaces  = &KNOWNFOLDERID{0xA440879F, 0x87A0, 0x4F7D, [8]byte{0xB7, 0x00, 0x02, 0x07, 0xB9, 0x66, 0x19, 0x4A}}
+	FOLDERID_ApplicationShortcuts   = &KNOWNFOLDERID{0xA3918781, 0xE5F2, 0x4890, [8]byte{0xB3, 0xD9, 0xA7, 0xE5, 0x43, 0x32, 0x32, 0x8C}}
+	FOLDERID_RoamingTiles           = &KNOWNFOLDERID{0x00BCFC5A, 0xED94, 0x4E48, [8]byte{0x96, 0xA1, 0x3F, 0x62, 0x17, 0xF2, 0x19, 0x90}}
+	FOLDERID_RoamedTileImages       = &KNOWNFOLDERID{0xAAA8D5A5, 0xF1D6, 0x4259, [8]byte{0xBA, 0xA8, 0x78, 0xE7, 0xEF, 0x60, 0x83, 0x5E}}
+	FOLDERID_Screenshots            = &KNOWNFOLDERID{0xB7BEDE81, 0xDF94, 0x4682, [8]byte{0xA7, 0xD8, 0x57, 0xA5, 0x26, 0x20, 0xB8, 0x6F}}
+	FOLDERID_CameraRoll             = &KNOWNFOLDERID{0xAB5FB87B, 0x7CE2, 0x4F83, [8]byte{0x91, 0x5D, 0x55, 0x08, 0x46, 0xC9, 0x53, 0x7B}}
+	FOLDERID_SkyDrive               = &KNOWNFOLDERID{0xA52BBA46, 0xE9E1, 0x435F, [8]byte{0xB3, 0xD9, 0x28, 0xDA, 0xA6, 0x48, 0xC0, 0xF6}}
+	FOLDERID_OneDrive               = &KNOWNFOLDERID{0xA52BBA46, 0xE9E1, 0x435F, [8]byte{0xB3, 0xD9, 0x28, 0xDA, 0xA6, 0x48, 0xC0, 0xF6}}
+	FOLDERID_SkyDriveDocuments      = &KNOWNFOLDERID{0x24D89E24, 0x2F19, 0x4534, [8]byte{0x9D, 0xDE, 0x6A, 0x66, 0x71, 0xFB, 0xB8, 0xFE}}
+	FOLDERID_SkyDrivePictures       = &KNOWNFOLDERID{0x339719B5, 0x8C47, 0x4894, [8]byte{0x94, 0xC2, 0xD8, 0xF7, 0x7A, 0xDD, 0x44, 0xA6}}
+	FOLDERID_SkyDriveMusic          = &KNOWNFOLDERID{0xC3F2459E, 0x80D6, 0x45DC, [8]byte{0xBF, 0xEF, 0x1F, 0x76, 0x9F, 0x2B, 0xE7, 0x30}}
+	FOLDERID_SkyDriveCameraRoll     = &KNOWNFOLDERID{0x767E6811, 0x49CB, 0x4273, [8]byte{0x87, 0xC2, 0x20, 0xF3, 0x55, 0xE1, 0x08, 0x5B}}
+	FOLDERID_SearchHistory          = &KNOWNFOLDERID{0x0D4C3DB6, 0x03A3, 0x462F, [8]byte{0xA0, 0xE6, 0x08, 0x92, 0x4C, 0x41, 0xB5, 0xD4}}
+	FOLDERID_SearchTemplates        = &KNOWNFOLDERID{0x7E636BFE, 0xDFA9, 0x4D5E, [8]byte{0xB4, 0x56, 0xD7, 0xB3, 0x98, 0x51, 0xD8, 0xA9}}
+	FOLDERID_CameraRollLibrary      = &KNOWNFOLDERID{0x2B20DF75, 0x1EDA, 0x4039, [8]byte{0x80, 0x97, 0x38, 0x79, 0x82, 0x27, 0xD5, 0xB7}}
+	FOLDERID_SavedPictures          = &KNOWNFOLDERID{0x3B193882, 0xD3AD, 0x4EAB, [8]byte{0x96, 0x5A, 0x69, 0x82, 0x9D, 0x1F, 0xB5, 0x9F}}
+	FOLDERID_SavedPicturesLibrary   = &KNOWNFOLDERID{0xE25B5812, 0xBE88, 0x4BD9, [8]byte{0x94, 0xB0, 0x29, 0x23, 0x34, 0x77, 0xB6, 0xC3}}
+	FOLDERID_RetailDemo             = &KNOWNFOLDERID{0x12D4C69E, 0x24AD, 0x4923, [8]byte{0xBE, 0x19, 0x31, 0x32, 0x1C, 0x43, 0xA7, 0x67}}
+	FOLDERID_Device                 = &KNOWNFOLDERID{0x1C2AC1DC, 0x4358, 0x4B6C, [8]byte{0x97, 0x33, 0xAF, 0x21, 0x15, 0x65, 0x76, 0xF0}}
+	FOLDERID_DevelopmentFiles       = &KNOWNFOLDERID{0xDBE8E08E, 0x3053, 0x4BBC, [8]byte{0xB1, 0x83, 0x2A, 0x7B, 0x2B, 0x19, 0x1E, 0x59}}
+	FOLDERID_Objects3D              = &KNOWNFOLDERID{0x31C0DD25, 0x9439, 0x4F12, [8]byte{0xBF, 0x41, 0x7F, 0xF4, 0xED, 0xA3, 0x87, 0x22}}
+	FOLDERID_AppCaptures            = &KNOWNFOLDERID{0xEDC0FE71, 0x98D8, 0x4F4A, [8]byte{0xB9, 0x20, 0xC8, 0xDC, 0x13, 0x3C, 0xB1, 0x65}}
+	FOLDERID_LocalDocuments         = &KNOWNFOLDERID{0xF42EE2D3, 0x909F, 0x4907, [8]byte{0x88, 0x71, 0x4C, 0x22, 0xFC, 0x0B, 0xF7, 0x56}}
+	FOLDERID_LocalPictures          = &KNOWNFOLDERID{0x0DDD015D, 0xB06C, 0x45D5, [8]byte{0x8C, 0x4C, 0xF5, 0x97, 0x13, 0x85, 0x46, 0x39}}
+	FOLDERID_LocalVideos            = &KNOWNFOLDERID{0x35286A68, 0x3C57, 0x41A1, [8]byte{0xBB, 0xB1, 0x0E, 0xAE, 0x73, 0xD7, 0x6C, 0x95}}
+	FOLDERID_LocalMusic             = &KNOWNFOLDERID{0xA0C69A99, 0x21C8, 0x4671, [8]byte{0x87, 0x03, 0x79, 0x34, 0x16, 0x2F, 0xCF, 0x1D}}
+	FOLDERID_LocalDownloads         = &KNOWNFOLDERID{0x7D83EE9B, 0x2244, 0x4E70, [8]byte{0xB1, 0xF5, 0x53, 0x93, 0x04, 0x2A, 0xF1, 0xE4}}
+	FOLDERID_RecordedCalls          = &KNOWNFOLDERID{0x2F8B40C2, 0x83ED, 0x48EE, [8]byte{0xB3, 0x83, 0xA1, 0xF1, 0x57, 0xEC, 0x6F, 0x9A}}
+	FOLDERID_AllAppMods             = &KNOWNFOLDERID{0x7AD67899, 0x66AF, 0x43BA, [8]byte{0x91, 0x56, 0x6A, 0xAD, 0x42, 0xE6, 0xC5, 0x96}}
+	FOLDERID_CurrentAppMods         = &KNOWNFOLDERID{0x3DB40B20, 0x2A30, 0x4DBE, [8]byte{0x91, 0x7E, 0x77, 0x1D, 0xD2, 0x1D, 0xD0, 0x99}}
+	FOLDERID_AppDataDesktop         = &KNOWNFOLDERID{0xB2C5E279, 0x7ADD, 0x439F, [8]byte{0xB2, 0x8C, 0xC4, 0x1F, 0xE1, 0xBB, 0xF6, 0x72}}
+	FOLDERID_AppDataDocuments       = &KNOWNFOLDERID{0x7BE16610, 0x1F7F, 0x44AC, [8]byte{0xBF, 0xF0, 0x83, 0xE1, 0x5F, 0x2F, 0xFC, 0xA1}}
+	FOLDERID_AppDataFavorites       = &KNOWNFOLDERID{0x7CFBEFBC, 0xDE1F, 0x45AA, [8]byte{0xB8, 0x43, 0xA5, 0x42, 0xAC, 0x53, 0x6C, 0xC9}}
+	FOLDERID_AppDataProgramData     = &KNOWNFOLDERID{0x559D40A3, 0xA036, 0x40FA, [8]byte{0xAF, 0x61, 0x84, 0xCB, 0x43, 0x0A, 0x4D, 0x34}}
+)
+
+// knownFolders pairs each [KNOWNFOLDERID] with its Go identifier suffix and
+// its canonical name as registered under the FolderDescriptions key.
+var knownFolders = []struct {
+	ident     string
+	canonical string
+	id        *KNOWNFOLDERID
+}{
+	{"NetworkFolder", "NetworkPlacesFolder", FOLDERID_NetworkFolder},
+	{"ComputerFolder", "MyComputerFolder", FOLDERID_ComputerFolder},
+	{"InternetFolder", "InternetFolder", FOLDERID_InternetFolder},
+	{"ControlPanelFolder", "ControlPanelFolder", FOLDERID_ControlPanelFolder},
+	{"PrintersFolder", "PrintersFolder", FOLDERID_PrintersFolder},
+	{"SyncManagerFolder", "SyncCenterFolder", FOLDERID_SyncManagerFolder},
+	{"SyncSetupFolder", "SyncSetupFolder", FOLDERID_SyncSetupFolder},
+	{"ConflictFolder", "ConflictFolder", FOLDERID_ConflictFolder},
+	{"SyncResultsFolder", "SyncResultsFolder", FOLDERID_SyncResultsFolder},
+	{"RecycleBinFolder", "RecycleBinFolder", FOLDERID_RecycleBinFolder},
+	{"ConnectionsFolder", "ConnectionsFolder", FOLDERID_ConnectionsFolder},
+	{"Fonts", "Fonts", FOLDERID_Fonts},
+	{"Desktop", "Desktop", FOLDERID_Desktop},
+	{"Startup", "Startup", FOLDERID_Startup},
+	{"Programs", "Programs", FOLDERID_Programs},
+	{"StartMenu", "Start Menu", FOLDERID_StartMenu},
+	{"Recent", "Recent", FOLDERID_Recent},
+	{"SendTo", "SendTo", FOLDERID_SendTo},
+	{"Documents", "Personal", FOLDERID_Documents},
+	{"Favorites", "Favorites", FOLDERID_Favorites},
+	{"NetHood", "NetHood", FOLDERID_NetHood},
+	{"PrintHood", "PrintHood", FOLDERID_PrintHood},
+	{"Templates", "Templates", FOLDERID_Templates},
+	{"CommonStartup", "Common Startup", FOLDERID_CommonStartup},
+	{"CommonPrograms", "Common Programs", FOLDERID_CommonPrograms},
+	{"CommonStartMenu", "Common Start Menu", FOLDERID_CommonStartMenu},
+	{"PublicDesktop", "Common Desktop", FOLDERID_PublicDesktop},
+	{"ProgramData", "Common AppData", FOLDERID_ProgramData},
+	{"CommonTemplates", "Common Templates", FOLDERID_CommonTemplates},
+	{"PublicDocuments", "Common Documents", FOLDERID_PublicDocuments},
+	{"RoamingAppData", "AppData", FOLDERID_RoamingAppData},
+	{"LocalAppData", "Local AppData", FOLDERID_LocalAppData},
+	{"LocalAppDataLow", "LocalAppDataLow", FOLDERID_LocalAppDataLow},
+	{"InternetCache", "Cache", FOLDERID_InternetCache},
+	{"Cookies", "Cookies", FOLDERID_Cookies},
+	{"History", "History", FOLDERID_History},
+	{"System", "System", FOLDERID_System},
+	{"SystemX86", "SystemX86", FOLDERID_SystemX86},
+	{"Windows", "Windows", FOLDERID_Windows},
+	{"Profile", "Profile", FOLDERID_Profile},
+	{"Pictures", "My Pictures", FOLDERID_Pictures},
+	{"ProgramFilesX86", "ProgramFilesX86", FOLDERID_ProgramFilesX86},
+	{"ProgramFilesCommonX86", "ProgramFilesCommonX86", FOLDERID_ProgramFilesCommonX86},
+	{"ProgramFilesX64", "ProgramFilesX64", FOLDERID_ProgramFilesX64},
+	{"ProgramFilesCommonX64", "ProgramFilesCommonX64", FOLDERID_ProgramFilesCommonX64},
+	{"ProgramFiles", "ProgramFiles", FOLDERID_ProgramFiles},
+	{"ProgramFilesCommon", "ProgramFilesCommon", FOLDERID_ProgramFilesCommon},
+	{"UserProgramFiles", "UserProgramFiles", FOLDERID_UserProgramFiles},
+	{"UserProgramFilesCommon", "UserProgramFilesCommon", FOLDERID_UserProgramFilesCommon},
+	{"AdminTools", "Administrative Tools", FOLDERID_AdminTools},
+	{"CommonAdminTools", "Common Administrative Tools", FOLDERID_CommonAdminTools},
+	{"Music", "My Music", FOLDERID_Music},
+	{"Videos", "My Video", FOLDERID_Videos},
+	{"Ringtones", "Ringtones", FOLDERID_Ringtones},
+	{"PublicPictures", "CommonPictures", FOLDERID_PublicPictures},
+	{"PublicMusic", "CommonMusic", FOLDERID_PublicMusic},
+	{"PublicVideos", "CommonVideo", FOLDERID_PublicVideos},
+	{"PublicRingtones", "CommonRingtones", FOLDERID_PublicRingtones},
+	{"ResourceDir", "ResourceDir", FOLDERID_ResourceDir},
+	{"LocalizedResourcesDir", "LocalizedResourcesDir", FOLDERID_LocalizedResourcesDir},
+	{"CommonOEMLinks", "OEM Links", FOLDERID_CommonOEMLinks},
+	{"CDBurning", "CD Burning", FOLDERID_CDBurning},
+	{"UserProfiles", "UserProfiles", FOLDERID_UserProfiles},
+	{"Playlists", "Playlists", FOLDERID_Playlists},
+	{"SamplePlaylists", "SamplePlaylists", FOLDERID_SamplePlaylists},
+	{"SampleMusic", "SampleMusic", FOLDERID_SampleMusic},
+	{"SamplePictures", "SamplePictures", FOLDERID_SamplePictures},
+	{"SampleVideos", "SampleVideos", FOLDERID_SampleVideos},
+	{"PhotoAlbums", "PhotoAlbums", FOLDERID_PhotoAlbums},
+	{"Public", "Public", FOLDERID_Public},
+	{"ChangeRemovePrograms", "ChangeRemoveProgramsFolder", FOLDERID_ChangeRemovePrograms},
+	{"AppUpdates", "AppUpdatesFolder", FOLDERID_AppUpdates},
+	{"AddNewPrograms", "AddNewProgramsFolder", FOLDERID_AddNewPrograms},
+	{"Downloads", "Downloads", FOLDERID_Downloads},
+	{"PublicDownloads", "CommonDownloads", FOLDERID_PublicDownloads},
+	{"SavedSearches", "Searches", FOLDERID_SavedSearches},
+	{"QuickLaunch", "Quick Launch", FOLDERID_QuickLaunch},
+	{"Contacts", "Contacts", FOLDERID_Contacts},
+	{"SidebarParts", "Gadgets", FOLDERID_SidebarParts},
+	{"SidebarDefaultParts", "Default Gadgets", FOLDERID_SidebarDefaultParts},
+	{"PublicGameTasks", "PublicGameTasks", FOLDERID_PublicGameTasks},
+	{"GameTasks", "GameTasks", FOLDERID_GameTasks},
+	{"SavedGames", "SavedGames", FOLDERID_SavedGames},
+	{"Games", "Games", FOLDERID_Games},
+	{"SEARCH_MAPI", "MAPIFolder", FOLDERID_SEARCH_MAPI},
+	{"SEARCH_CSC", "CSCFolder", FOLDERID_SEARCH_CSC},
+	{"Links", "Links", FOLDERID_Links},
+	{"UsersFiles", "UsersFilesFolder", FOLDERID_UsersFiles},
+	{"UsersLibraries", "UsersLibrariesFolder", FOLDERID_UsersLibraries},
+	{"SearchHome", "SearchHomeFolder", FOLDERID_SearchHome},
+	{"OriginalImages", "Original Images", FOLDERID_OriginalImages},
+	{"DocumentsLibrary", "DocumentsLibrary", FOLDERID_DocumentsLibrary},
+	{"MusicLibrary", "MusicLibrary", FOLDERID_MusicLibrary},
+	{"PicturesLibrary", "PicturesLibrary", FOLDERID_PicturesLibrary},
+	{"VideosLibrary", "VideosLibrary", FOLDERID_VideosLibrary},
+	{"RecordedTVLibrary", "RecordedTVLibrary", FOLDERID_RecordedTVLibrary},
+	{"HomeGroup", "HomeGroupFolder", FOLDERID_HomeGroup},
+	{"HomeGroupCurrentUser", "HomeGroupCurrentUserFolder", FOLDERID_HomeGroupCurrentUser},
+	{"DeviceMetadataStore", "Device Metadata Store", FOLDERID_DeviceMetadataStore},
+	{"Libraries", "Libraries", FOLDERID_Libraries},
+	{"PublicLibraries", "PublicLibraries", FOLDERID_PublicLibraries},
+	{"UserPinned", "User Pinned", FOLDERID_UserPinned},
+	{"ImplicitAppShortcuts", "ImplicitAppShortcuts", FOLDERID_ImplicitAppShortcuts},
+	{"AccountPictures", "AccountPictures", FOLDERID_AccountPictures},
+	{"PublicUserTiles", "PublicUserTiles", FOLDERID_PublicUserTiles},
+	{"AppsFolder", "AppsFolder", FOLDERID_AppsFolder},
+	{"StartMenuAllPrograms", "StartMenuAllPrograms", FOLDERID_StartMenuAllPrograms},
+	{"CommonStartMenuPlaces", "CommonStartMenuPlaces", FOLDERID_CommonStartMenuPlaces},
+	{"ApplicationShortcuts", "Application Shortcuts", FOLDERID_ApplicationShortcuts},
+	{"RoamingTiles", "RoamingTiles", FOLDERID_RoamingTiles},
+	{"RoamedTileImages", "RoamedTileImages", FOLDERID_RoamedTileImages},
+	{"Screenshots", "Screenshots", FOLDERID_Screenshots},
+	{"CameraRoll", "Camera Roll", FOLDERID_CameraRoll},
+	{"OneDrive", "OneDrive", FOLDERID_OneDrive},
+	{"SkyDriveDocuments", "OneDriveDocuments", FOLDERID_SkyDriveDocuments},
+	{"SkyDrivePictures", "OneDrivePictures", FOLDERID_SkyDrivePictures},
+	{"SkyDriveMusic", "OneDriveMusic", FOLDERID_SkyDriveMusic},
+	{"SkyDriveCameraRoll", "OneDriveCameraRoll", FOLDERID_SkyDriveCameraRoll},
+	{"SearchHistory", "SearchHistoryFolder", FOLDERID_SearchHistory},
+	{"SearchTemplates", "SearchTemplatesFolder", FOLDERID_SearchTemplates},
+	{"CameraRollLibrary", "CameraRollLibrary", FOLDERID_CameraRollLibrary},
+	{"SavedPictures", "SavedPictures", FOLDERID_SavedPictures},
+	{"SavedPicturesLibrary", "SavedPicturesLibrary", FOLDERID_SavedPicturesLibrary},
+	{"RetailDemo", "RetailDemo", FOLDERID_RetailDemo},
+	{"Device", "Device", FOLDERID_Device},
+	{"DevelopmentFiles", "DevelopmentFiles", FOLDERID_DevelopmentFiles},
+	{"Objects3D", "3D Objects", FOLDERID_Objects3D},
+	{"AppCaptures", "AppCaptures", FOLDERID_AppCaptures},
+	{"LocalDocuments", "Local Documents", FOLDERID_LocalDocuments},
+	{"LocalPictures", "Local Pictures", FOLDERID_LocalPictures},
+	{"LocalVideos", "Local Videos", FOLDERID_LocalVideos},
+	{"LocalMusic", "Local Music", FOLDERID_LocalMusic},
+	{"LocalDownloads", "Local Downloads", FOLDERID_LocalDownloads},
+	{"RecordedCalls", "Recorded Calls", FOLDERID_RecordedCalls},
+	{"AllAppMods", "AllAppMods", FOLDERID_AllAppMods},
+	{"CurrentAppMods", "CurrentAppMods", FOLDERID_CurrentAppMods},
+	{"AppDataDesktop", "AppDataDesktop", FOLDERID_AppDataDesktop},
+	{"AppDataDocuments", "AppDataDocuments", FOLDERID_AppDataDocuments},
+	{"AppDataFavorites", "AppDataFavorites", FOLDERID_AppDataFavorites},
+	{"AppDataProgramData", "AppDataProgramData", FOLDERID_AppDataProgramData},
+}
+
+// KnownFolderByName looks up a [KNOWNFOLDERID] by name. The name may be either
+// the folder's canonical name (e.g. "Local AppData", "Common AppData") or the
+// suffix of its FOLDERID_ identifier (e.g. "LocalAppData", "ProgramData").
+// Matching is case-insensitive.
+// It returns nil and false if no known folder has that name.
+func KnownFolderByName(name string) (*KNOWNFOLDERID, bool) {
+	for _, kf := range knownFolders {
+		if strings.EqualFold(name, kf.canonical) || strings.EqualFold(name, kf.ident) {
+			return kf.id, true
+		}
+	}
+
+	return nil, false
+}
+
+// KnownFolderName returns the canonical name of the known folder identified by
+// id.
+// It returns an empty string and false if id is not a known folder.
+func KnownFolderName(id *KNOWNFOLDERID) (string, bool) {
+	if id == nil {
+		return "", false
+	}
+
+	for _, kf := range knownFolders {
+		if *kf.id == *id {
+			return kf.canonical, true
+		}
+	}
+
+	return "", false
+}
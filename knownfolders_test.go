@@ -0,0 +1,76 @@
+package winapi_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kamaranl/gotools/test"
+	"github.com/kamaranl/winapi"
+)
+
+func TestKnownFolderByName(t *testing.T) {
+	tName := "KnownFolderByName"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	scenes := []test.Scene{
+		{Input: "Desktop", Output: winapi.FOLDERID_Desktop, Passing: true},
+		{Input: "downloads", Output: winapi.FOLDERID_Downloads, Passing: true},
+		{Input: "Local AppData", Output: winapi.FOLDERID_LocalAppData, Passing: true},
+		{Input: "LocalAppData", Output: winapi.FOLDERID_LocalAppData, Passing: true},
+		{Input: "AppData", Output: winapi.FOLDERID_RoamingAppData, Passing: true},
+		{Input: "RoamingAppData", Output: winapi.FOLDERID_RoamingAppData, Passing: true},
+		{Input: "Common AppData", Output: winapi.FOLDERID_ProgramData, Passing: true},
+		{Input: "Personal", Output: winapi.FOLDERID_Documents, Passing: true},
+		{Input: "SENDTO", Output: winapi.FOLDERID_SendTo, Passing: true},
+		{Input: "Startup", Output: winapi.FOLDERID_Startup, Passing: true},
+		{Input: "Not A Folder", Output: (*winapi.KNOWNFOLDERID)(nil), Passing: false},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			got, ok := winapi.KnownFolderByName(s.Input.(string))
+			want := s.Output.(*winapi.KNOWNFOLDERID)
+
+			if ok != s.Passing {
+				t.Fatalf(test.ErrWantFGotF, s.Passing, ok)
+			}
+			if got != want {
+				t.Errorf(test.ErrWantFGotF, want, got)
+			}
+		})
+	}
+}
+
+func TestKnownFolderName(t *testing.T) {
+	tName := "KnownFolderName"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	scenes := []test.Scene{
+		{Input: winapi.FOLDERID_RoamingAppData, Output: "AppData", Passing: true},
+		{Input: winapi.FOLDERID_SkyDrive, Output: "OneDrive", Passing: true},
+		{Input: &winapi.KNOWNFOLDERID{Data1: 1}, Output: "", Passing: false},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			got, ok := winapi.KnownFolderName(s.Input.(*winapi.KNOWNFOLDERID))
+			want := s.Output.(string)
+
+			if ok != s.Passing {
+				t.Fatalf(test.ErrWantFGotF, s.Passing, ok)
+			}
+			if got != want {
+				t.Errorf(test.ErrWantFGotF, want, got)
+			}
+		})
+	}
+
+	id := winapi.FOLDERID_Desktop.String()
+	if want := "{B4BFCC3A-DB2C-424C-B029-7FE99A87C641}"; id != want {
+		t.Errorf(test.ErrWantFGotF, want, id)
+	}
+}
@@ -0,0 +1,159 @@
+//go:build 386 || amd64 || arm64
+
+package winapi_test
+
+import (
+	"fmt"
+	"testing"
+	"unsafe"
+
+	"github.com/kamaranl/gotools/test"
+	"github.com/kamaranl/winapi"
+)
+
+// layout is the size and member offsets of a struct, in bytes.
+type layout struct {
+	Size    uintptr
+	Offsets []uintptr
+}
+
+// TestLayout checks the structs passed to SendInput, the message functions and
+// the display and shell functions against the sizes and offsets of winuser.h,
+// wingdi.h, windef.h and shellapi.h in the Windows SDK,
+// as reported by sizeof and offsetof for x64/ARM64 and x86.
+func TestLayout(t *testing.T) {
+	tName := "Layout"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	is64 := unsafe.Sizeof(uintptr(0)) == 8
+	sdk := func(x64, x86 layout) layout {
+		if is64 {
+			return x64
+		}
+		return x86
+	}
+
+	var (
+		pt  winapi.POINT
+		msg winapi.MSG
+		mi  winapi.MOUSEINPUT
+		ki  winapi.KEYBDINPUT
+		hi  winapi.HARDWAREINPUT
+		in  winapi.INPUT
+		inM winapi.INPUT_Mi
+		inK winapi.INPUT_Ki
+		inH winapi.INPUT_Hi
+		dm  winapi.DEVMODEW
+		dd  winapi.DISPLAY_DEVICEW
+		wp  winapi.WINDOWPLACEMENT
+		rb  winapi.SHQUERYRBINFO
+	)
+
+	// MSG ends with LPrivate, which the SDK only declares for _MAC, so its
+	// size on x86 is 4 bytes larger than sizeof(MSG) (28).
+	scenes := []test.Scene{
+		{
+			Input:  layout{unsafe.Sizeof(pt), []uintptr{unsafe.Offsetof(pt.X), unsafe.Offsetof(pt.Y)}},
+			Output: sdk(layout{8, []uintptr{0, 4}}, layout{8, []uintptr{0, 4}}),
+		},
+		{
+			Input: layout{unsafe.Sizeof(msg), []uintptr{
+				unsafe.Offsetof(msg.Hwnd), unsafe.Offsetof(msg.Message), unsafe.Offsetof(msg.WParam),
+				unsafe.Offsetof(msg.LParam), unsafe.Offsetof(msg.Time), unsafe.Offsetof(msg.Pt),
+			}},
+			Output: sdk(layout{48, []uintptr{0, 8, 16, 24, 32, 36}}, layout{32, []uintptr{0, 4, 8, 12, 16, 20}}),
+		},
+		{
+			Input: layout{unsafe.Sizeof(mi), []uintptr{
+				unsafe.Offsetof(mi.X), unsafe.Offsetof(mi.Y), unsafe.Offsetof(mi.MouseData),
+				unsafe.Offsetof(mi.Flags), unsafe.Offsetof(mi.Time), unsafe.Offsetof(mi.ExtraInfo),
+			}},
+			Output: sdk(layout{32, []uintptr{0, 4, 8, 12, 16, 24}}, layout{24, []uintptr{0, 4, 8, 12, 16, 20}}),
+		},
+		{
+			Input: layout{unsafe.Sizeof(ki), []uintptr{
+				unsafe.Offsetof(ki.Vk), unsafe.Offsetof(ki.Scan), unsafe.Offsetof(ki.Flags),
+				unsafe.Offsetof(ki.Time), unsafe.Offsetof(ki.ExtraInfo),
+			}},
+			Output: sdk(layout{24, []uintptr{0, 2, 4, 8, 16}}, layout{16, []uintptr{0, 2, 4, 8, 12}}),
+		},
+		{
+			Input: layout{unsafe.Sizeof(hi), []uintptr{
+				unsafe.Offsetof(hi.Msg), unsafe.Offsetof(hi.ParamL), unsafe.Offsetof(hi.ParamH),
+			}},
+			Output: sdk(layout{8, []uintptr{0, 4, 6}}, layout{8, []uintptr{0, 4, 6}}),
+		},
+		{
+			Input: layout{unsafe.Sizeof(in), []uintptr{
+				unsafe.Offsetof(in.Type),
+				uintptr(unsafe.Pointer(in.Mi())) - uintptr(unsafe.Pointer(&in)),
+				uintptr(unsafe.Pointer(in.Ki())) - uintptr(unsafe.Pointer(&in)),
+				uintptr(unsafe.Pointer(in.Hi())) - uintptr(unsafe.Pointer(&in)),
+			}},
+			Output: sdk(layout{40, []uintptr{0, 8, 8, 8}}, layout{28, []uintptr{0, 4, 4, 4}}),
+		},
+		{
+			Input:  layout{unsafe.Sizeof(inM), []uintptr{unsafe.Offsetof(inM.Type), unsafe.Offsetof(inM.Mi)}},
+			Output: sdk(layout{40, []uintptr{0, 8}}, layout{28, []uintptr{0, 4}}),
+		},
+		{
+			Input:  layout{unsafe.Sizeof(inK), []uintptr{unsafe.Offsetof(inK.Type), unsafe.Offsetof(inK.Ki)}},
+			Output: sdk(layout{40, []uintptr{0, 8}}, layout{28, []uintptr{0, 4}}),
+		},
+		{
+			Input:  layout{unsafe.Sizeof(inH), []uintptr{unsafe.Offsetof(inH.Type), unsafe.Offsetof(inH.Hi)}},
+			Output: sdk(layout{40, []uintptr{0, 8}}, layout{28, []uintptr{0, 4}}),
+		},
+		{
+			Input: layout{unsafe.Sizeof(dm), []uintptr{
+				unsafe.Offsetof(dm.SpecVersion), unsafe.Offsetof(dm.Size), unsafe.Offsetof(dm.Fields),
+				unsafe.Offsetof(dm.Position), unsafe.Offsetof(dm.DisplayOrientation), unsafe.Offsetof(dm.FormName),
+				unsafe.Offsetof(dm.BitsPerPel), unsafe.Offsetof(dm.PelsWidth), unsafe.Offsetof(dm.PelsHeight),
+				unsafe.Offsetof(dm.DisplayFrequency), unsafe.Offsetof(dm.PanningHeight),
+			}},
+			Output: layout{220, []uintptr{64, 68, 72, 76, 84, 102, 168, 172, 176, 184, 216}},
+		},
+		{
+			Input: layout{unsafe.Sizeof(dd), []uintptr{
+				unsafe.Offsetof(dd.DeviceName), unsafe.Offsetof(dd.DeviceString), unsafe.Offsetof(dd.StateFlags),
+				unsafe.Offsetof(dd.DeviceID), unsafe.Offsetof(dd.DeviceKey),
+			}},
+			Output: layout{840, []uintptr{4, 68, 324, 328, 584}},
+		},
+		{
+			Input: layout{unsafe.Sizeof(wp), []uintptr{
+				unsafe.Offsetof(wp.Flags), unsafe.Offsetof(wp.ShowCmd), unsafe.Offsetof(wp.MinPosition),
+				unsafe.Offsetof(wp.MaxPosition), unsafe.Offsetof(wp.NormalPosition),
+			}},
+			Output: layout{44, []uintptr{4, 8, 12, 20, 28}},
+		},
+		{
+			Input: layout{unsafe.Sizeof(rb), []uintptr{
+				unsafe.Offsetof(rb.CbSize), unsafe.Offsetof(rb.Size), unsafe.Offsetof(rb.NumItems),
+			}},
+			Output: sdk(layout{24, []uintptr{0, 8, 16}}, layout{20, []uintptr{0, 4, 12}}),
+		},
+	}
+
+	names := []string{
+		"POINT", "MSG", "MOUSEINPUT", "KEYBDINPUT", "HARDWAREINPUT",
+		"INPUT", "INPUT_Mi", "INPUT_Ki", "INPUT_Hi", "DEVMODEW", "DISPLAY_DEVICEW",
+		"WINDOWPLACEMENT", "SHQUERYRBINFO",
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			got, want := s.Input.(layout), s.Output.(layout)
+			if got.Size != want.Size {
+				t.Errorf("%s: "+test.ErrWantFGotF, names[i], want.Size, got.Size)
+			}
+			for j := range want.Offsets {
+				if got.Offsets[j] != want.Offsets[j] {
+					t.Errorf("%s member %d: "+test.ErrWantFGotF, names[i], j, want.Offsets[j], got.Offsets[j])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,476 @@
+package winapi
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf16"
+)
+
+// A Macro is a sequence of input actions written in the macro format, which
+// [ParseMacro] reads, [Macro.String] writes and [Macro.Compile] turns into an
+// [InputQueue]. The format is described in docs/macro.md.
+type Macro []MacroStmt
+
+// A MacroStmt is a single action of a [Macro].
+type MacroStmt struct {
+	// Op is the action.
+	Op MacroOp
+
+	// Key is the virtual key of MacroHold, MacroRelease and MacroTap.
+	Key uint16
+
+	// Text is the text of MacroType.
+	Text string
+
+	// Delay is the wait of MacroWait.
+	Delay time.Duration
+
+	// X and Y are the position of MacroMove, in pixels of the primary monitor,
+	// or the motion of MacroMoveBy, in mickeys.
+	X, Y int32
+
+	// Button is the button of MacroClick, MacroMouseDown and MacroMouseUp.
+	Button MouseButton
+
+	// Notches is the amount of MacroWheel and MacroHWheel, in wheel notches.
+	Notches int32
+
+	// Line and Col are the position of the statement in the source, from 1,
+	// or 0 if it was not parsed.
+	Line, Col int
+}
+
+// MacroOp represents the action of a [MacroStmt].
+type MacroOp uint8
+
+// [MacroOp] constants.
+const (
+	MacroHold MacroOp = iota + 1
+	MacroRelease
+	MacroTap
+	MacroType
+	MacroWait
+	MacroMove
+	MacroMoveBy
+	MacroClick
+	MacroMouseDown
+	MacroMouseUp
+	MacroWheel
+	MacroHWheel
+)
+
+// macroOps are the command of each [MacroOp].
+var macroOps = [...]string{
+	MacroHold:      "hold",
+	MacroRelease:   "release",
+	MacroTap:       "tap",
+	MacroType:      "type",
+	MacroWait:      "wait",
+	MacroMove:      "move",
+	MacroMoveBy:    "move by",
+	MacroClick:     "click",
+	MacroMouseDown: "mousedown",
+	MacroMouseUp:   "mouseup",
+	MacroWheel:     "wheel",
+	MacroHWheel:    "hwheel",
+}
+
+// wheelDelta is the wheel movement of one notch.
+const wheelDelta = 120
+
+// A MacroError reports an error in a macro at a line and column, both from 1.
+type MacroError struct {
+	Line, Col int
+	Msg       string
+}
+
+// Error implements the error interface.
+func (e *MacroError) Error() string {
+	return fmt.Sprintf("macro:%d:%d: %s", e.Line, e.Col, e.Msg)
+}
+
+// macroToken is a word or a quoted string of a macro statement.
+type macroToken struct {
+	text      string
+	quoted    bool
+	line, col int
+}
+
+// ParseMacro parses a macro from src. Statements are separated by newlines or
+// semicolons, and a # starts a comment that runs to the end of the line. A
+// macro cannot end with a wait.
+// It returns a [*MacroError] with the position of the first error, if any.
+//
+// Experimental: ParseMacro has not been tested or used internally.
+func ParseMacro(src string) (Macro, error) {
+	stmts, err := lexMacro(src)
+	if err != nil {
+		return nil, err
+	}
+
+	var m Macro
+	for _, toks := range stmts {
+		st, err := parseMacroStmt(toks)
+		if err != nil {
+			return nil, err
+		}
+		m = append(m, st)
+	}
+	if err := m.checkEnd(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// checkEnd returns a [*MacroError] if m ends with a wait, which would delay
+// nothing.
+func (m Macro) checkEnd() error {
+	if n := len(m); n > 0 && m[n-1].Op == MacroWait {
+		return &MacroError{m[n-1].Line, m[n-1].Col, "wait must be followed by an action"}
+	}
+
+	return nil
+}
+
+// lexMacro splits src into the tokens of each non-empty statement.
+func lexMacro(src string) ([][]macroToken, error) {
+	var (
+		stmts     [][]macroToken
+		toks      []macroToken
+		line      = 1
+		lineStart = 0
+	)
+
+	end := func() {
+		if len(toks) > 0 {
+			stmts = append(stmts, toks)
+			toks = nil
+		}
+	}
+
+	for i := 0; i < len(src); {
+		col := i - lineStart + 1
+		switch c := src[i]; c {
+		case ' ', '\t', '\r':
+			i++
+		case '\n':
+			end()
+			i++
+			line, lineStart = line+1, i
+		case ';':
+			end()
+			i++
+		case '#':
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+		case '"':
+			q, err := strconv.QuotedPrefix(src[i:])
+			if err != nil {
+				return nil, &MacroError{line, col, "unterminated or invalid string"}
+			}
+			text, _ := strconv.Unquote(q)
+			toks = append(toks, macroToken{text, true, line, col})
+			i += len(q)
+		default:
+			j := i
+			for j < len(src) && !strings.ContainsRune(" \t\r\n;#\"", rune(src[j])) {
+				j++
+			}
+			toks = append(toks, macroToken{src[i:j], false, line, col})
+			i = j
+		}
+	}
+	end()
+
+	return stmts, nil
+}
+
+// parseMacroStmt parses the tokens of a statement.
+func parseMacroStmt(toks []macroToken) (MacroStmt, error) {
+	cmd := toks[0]
+	st := MacroStmt{Line: cmd.line, Col: cmd.col}
+	args := toks[1:]
+
+	fail := func(tok macroToken, format string, a ...any) (MacroStmt, error) {
+		return MacroStmt{}, &MacroError{tok.line, tok.col, fmt.Sprintf(format, a...)}
+	}
+	// arg returns the only argument of cmd, which must be a word.
+	arg := func() (macroToken, bool) {
+		if len(args) != 1 || args[0].quoted {
+			return cmd, false
+		}
+		return args[0], true
+	}
+
+	if cmd.quoted {
+		return fail(cmd, "expected a command, got a string")
+	}
+
+	name := strings.ToLower(cmd.text)
+	switch st.Op = macroOpByName(name); st.Op {
+	case MacroHold, MacroRelease, MacroTap:
+		a, ok := arg()
+		if !ok {
+			return fail(cmd, "%s takes a key", name)
+		}
+		if st.Key, ok = vkByName(a.text); !ok {
+			return fail(a, "unknown key %q", a.text)
+		}
+	case MacroType:
+		if len(args) != 1 || !args[0].quoted {
+			return fail(cmd, "type takes a quoted string")
+		}
+		st.Text = args[0].text
+	case MacroWait:
+		a, ok := arg()
+		if !ok {
+			return fail(cmd, "wait takes a duration, such as 50ms")
+		}
+		d, err := time.ParseDuration(a.text)
+		if err != nil || d < 0 {
+			return fail(a, "invalid duration %q", a.text)
+		}
+		st.Delay = d
+	case MacroMove:
+		if len(args) > 0 && !args[0].quoted && strings.EqualFold(args[0].text, "by") {
+			st.Op, args = MacroMoveBy, args[1:]
+		}
+		if len(args) == 0 {
+			return fail(cmd, "move takes a position X,Y")
+		}
+		var xy strings.Builder
+		for _, a := range args {
+			if a.quoted {
+				return fail(a, "expected X,Y, got a string")
+			}
+			xy.WriteString(a.text)
+		}
+		x, y, ok := strings.Cut(xy.String(), ",")
+		px, errX := strconv.ParseInt(x, 10, 32)
+		py, errY := strconv.ParseInt(y, 10, 32)
+		if !ok || errX != nil || errY != nil {
+			return fail(args[0], "expected X,Y, got %q", xy.String())
+		}
+		st.X, st.Y = int32(px), int32(py)
+	case MacroClick, MacroMouseDown, MacroMouseUp:
+		if len(args) == 0 {
+			st.Button = MouseLeft
+			break
+		}
+		a, ok := arg()
+		if !ok {
+			return fail(cmd, "%s takes a button", name)
+		}
+		if st.Button, ok = mouseButtonByName(a.text); !ok {
+			return fail(a, "unknown button %q", a.text)
+		}
+	case MacroWheel, MacroHWheel:
+		a, ok := arg()
+		if !ok {
+			return fail(cmd, "%s takes a number of notches", name)
+		}
+		n, err := strconv.ParseInt(a.text, 10, 16)
+		if err != nil {
+			return fail(a, "invalid number of notches %q", a.text)
+		}
+		st.Notches = int32(n)
+	default:
+		return fail(cmd, "unknown command %q", cmd.text)
+	}
+
+	return st, nil
+}
+
+// macroOpByName returns the op of the command name, or 0 if there is none.
+// MacroMoveBy is parsed as MacroMove followed by "by".
+func macroOpByName(name string) MacroOp {
+	for op, cmd := range macroOps {
+		if cmd == name && MacroOp(op) != MacroMoveBy {
+			return MacroOp(op)
+		}
+	}
+
+	return 0
+}
+
+// mouseButtonByName returns the button named name, case-insensitive.
+func mouseButtonByName(name string) (MouseButton, bool) {
+	for b, info := range mouseButtons {
+		if strings.EqualFold(info.name, name) {
+			return MouseButton(b), true
+		}
+	}
+
+	return 0, false
+}
+
+// String formats m in the macro format, one statement per line, which
+// [ParseMacro] reads back.
+func (m Macro) String() string {
+	var b strings.Builder
+	for _, st := range m {
+		b.WriteString(st.String())
+		b.WriteByte('\n')
+	}
+
+	return b.String()
+}
+
+// String formats st as a statement of the macro format.
+func (st MacroStmt) String() string {
+	if int(st.Op) >= len(macroOps) || macroOps[st.Op] == "" {
+		return fmt.Sprintf("# invalid op %d", st.Op)
+	}
+
+	cmd := macroOps[st.Op]
+	switch st.Op {
+	case MacroHold, MacroRelease, MacroTap:
+		return cmd + " " + vkName(st.Key)
+	case MacroType:
+		return cmd + " " + strconv.Quote(st.Text)
+	case MacroWait:
+		return cmd + " " + st.Delay.String()
+	case MacroMove, MacroMoveBy:
+		return fmt.Sprintf("%s %d,%d", cmd, st.X, st.Y)
+	case MacroClick, MacroMouseDown, MacroMouseUp:
+		return cmd + " " + st.Button.String()
+	}
+
+	return fmt.Sprintf("%s %d", cmd, st.Notches)
+}
+
+// Compile turns m into an [InputQueue]. Actions not separated by a wait are
+// sent together, and MacroMove positions are converted to the normalized
+// absolute coordinates of SendInput with width and height, the size of the
+// primary monitor in pixels, which are only needed if m moves the mouse.
+// It returns a [*MacroError] if m has an invalid statement, ends with a wait,
+// or moves the mouse without a screen size.
+//
+// Experimental: Compile has not been tested or used internally.
+func (m Macro) Compile(width, height int32) (*InputQueue, error) {
+	if err := m.checkEnd(); err != nil {
+		return nil, err
+	}
+
+	q := &InputQueue{}
+
+	var delay time.Duration
+	add := func(inputs ...INPUT) {
+		if len(inputs) > 0 {
+			q.Add(delay, inputs...)
+			delay = 0
+		}
+	}
+
+	for _, st := range m {
+		switch st.Op {
+		case MacroHold:
+			add(NewInput(keyEvent(st.Key, false)))
+		case MacroRelease:
+			add(NewInput(keyEvent(st.Key, true)))
+		case MacroTap:
+			add(NewInput(keyEvent(st.Key, false)), NewInput(keyEvent(st.Key, true)))
+		case MacroType:
+			add(typeEvents(st.Text)...)
+		case MacroWait:
+			delay += st.Delay
+		case MacroMove:
+			if width <= 1 || height <= 1 {
+				return nil, &MacroError{st.Line, st.Col, "move needs the screen size"}
+			}
+			add(NewInput(MOUSEINPUT{
+				X:     normalizeCoord(st.X, width),
+				Y:     normalizeCoord(st.Y, height),
+				Flags: MOUSEEVENTF_MOVE | MOUSEEVENTF_ABSOLUTE,
+			}))
+		case MacroMoveBy:
+			add(NewInput(MOUSEINPUT{X: st.X, Y: st.Y, Flags: MOUSEEVENTF_MOVE}))
+		case MacroClick:
+			add(NewInput(st.Button.event(false)), NewInput(st.Button.event(true)))
+		case MacroMouseDown:
+			add(NewInput(st.Button.event(false)))
+		case MacroMouseUp:
+			add(NewInput(st.Button.event(true)))
+		case MacroWheel:
+			add(NewInput(MOUSEINPUT{MouseData: MiData(st.Notches * wheelDelta), Flags: MOUSEEVENTF_WHEEL}))
+		case MacroHWheel:
+			add(NewInput(MOUSEINPUT{MouseData: MiData(st.Notches * wheelDelta), Flags: MOUSEEVENTF_HWHEEL}))
+		default:
+			return nil, &MacroError{st.Line, st.Col, fmt.Sprintf("invalid op %d", st.Op)}
+		}
+	}
+
+	return q, nil
+}
+
+// DryRun compiles m like [Macro.Compile] and writes the events it would send
+// to w instead, one per line, with the time each is due.
+// It returns the error of [Macro.Compile] or of writing to w.
+//
+// Experimental: DryRun has not been tested or used internally.
+func (m Macro) DryRun(w io.Writer, width, height int32) error {
+	q, err := m.Compile(width, height)
+	if err != nil {
+		return err
+	}
+
+	for _, b := range q.Plan() {
+		for _, in := range b.Inputs {
+			if _, err := fmt.Fprintf(w, "%-10s %v\n", "+"+b.At.String(), in); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// typeEvents returns the events that type text: a down and up event with
+// KEYEVENTF_UNICODE for each UTF-16 code unit, and taps of Enter and Tab for
+// line breaks and tabs, which most programs do not accept as characters.
+func typeEvents(text string) []INPUT {
+	var events []INPUT
+	prev := rune(0)
+	for _, r := range text {
+		switch r {
+		case '\n':
+			if prev != '\r' {
+				events = append(events, NewInput(keyEvent(VK_RETURN, false)), NewInput(keyEvent(VK_RETURN, true)))
+			}
+		case '\r':
+			events = append(events, NewInput(keyEvent(VK_RETURN, false)), NewInput(keyEvent(VK_RETURN, true)))
+		case '\t':
+			events = append(events, NewInput(keyEvent(VK_TAB, false)), NewInput(keyEvent(VK_TAB, true)))
+		default:
+			var units []uint16
+			units = utf16.AppendRune(units, r)
+			for _, u := range units {
+				events = append(events,
+					NewInput(KEYBDINPUT{Scan: u, Flags: KEYEVENTF_UNICODE}),
+					NewInput(KEYBDINPUT{Scan: u, Flags: KEYEVENTF_UNICODE | KEYEVENTF_KEYUP}),
+				)
+			}
+		}
+		prev = r
+	}
+
+	return events
+}
+
+// normalizeCoord converts the pixel coordinate c of a screen side of size
+// pixels to the normalized absolute coordinates of SendInput, from 0 to 65535.
+// A side of one pixel or less maps every coordinate to 0.
+func normalizeCoord(c, size int32) int32 {
+	if size <= 1 {
+		return 0
+	}
+
+	n := (int64(c)*65535 + int64(size-1)/2) / int64(size-1)
+
+	return int32(max(min(n, 1<<31-1), -1<<31))
+}
@@ -0,0 +1,190 @@
+package winapi_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/kamaranl/gotools/test"
+	"github.com/kamaranl/winapi"
+)
+
+func TestParseMacro(t *testing.T) {
+	tName := "ParseMacro"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	scenes := []test.Scene{
+		{
+			Input:   `hold LCtrl; tap C; release LCtrl; wait 50ms; type "hello"; move 100,200; click left`,
+			Output:  "hold LCtrl\ntap C\nrelease LCtrl\nwait 50ms\ntype \"hello\"\nmove 100,200\nclick left\n",
+			Passing: true,
+		},
+		{
+			Input:   "# comment\n  TAP f5 # trailing\n\nmove by -3, 4;;click\nmousedown X2; wheel -2\nhwheel 1; tap 0xE2; tap return",
+			Output:  "tap F5\nmove by -3,4\nclick left\nmousedown x2\nwheel -2\nhwheel 1\ntap 0xE2\ntap Enter\n",
+			Passing: true,
+		},
+		{
+			Input:   `type "tab\there \"quoted\" ünïcode"`,
+			Output:  "type \"tab\\there \\\"quoted\\\" ünïcode\"\n",
+			Passing: true,
+		},
+		{Input: "", Output: "", Passing: true},
+		{Input: "tap C\n  press C", Output: `macro:2:3: unknown command "press"`},
+		{Input: "hold Ctl", Output: `macro:1:6: unknown key "Ctl"`},
+		{Input: "tap; tap A B", Output: "macro:1:1: tap takes a key"},
+		{Input: "wait soon", Output: `macro:1:6: invalid duration "soon"`},
+		{Input: "wait -1s", Output: `macro:1:6: invalid duration "-1s"`},
+		{Input: "\n\ntype \"open", Output: "macro:3:6: unterminated or invalid string"},
+		{Input: "type hello", Output: "macro:1:1: type takes a quoted string"},
+		{Input: "move 100", Output: `macro:1:6: expected X,Y, got "100"`},
+		{Input: "click thumb", Output: `macro:1:7: unknown button "thumb"`},
+		{Input: "wheel 40000", Output: `macro:1:7: invalid number of notches "40000"`},
+		{Input: `"tap" A`, Output: "macro:1:1: expected a command, got a string"},
+		{Input: "tap A; wait 1s", Output: "macro:1:8: wait must be followed by an action"},
+		{Input: "wait 1s # pause\n\n", Output: "macro:1:1: wait must be followed by an action"},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			m, err := winapi.ParseMacro(s.Input.(string))
+			if !s.Passing {
+				var merr *winapi.MacroError
+				if !errors.As(err, &merr) || err.Error() != s.Output {
+					t.Errorf(test.ErrWantFGotF, s.Output, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf(test.ErrUnexpectedF, err)
+			}
+			if got := m.String(); got != s.Output {
+				t.Errorf(test.ErrWantFGotF, s.Output, got)
+			}
+		})
+	}
+}
+
+func TestMacroDryRun(t *testing.T) {
+	tName := "MacroDryRun"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	scenes := []test.Scene{
+		{
+			Input: "hold LCtrl; tap C; release LCtrl; wait 50ms; type \"h\\n\"; wait 1s; move 1919,0; click right",
+			Output: []string{
+				"+0s        key down LCtrl",
+				"+0s        key down C",
+				"+0s        key up C",
+				"+0s        key up LCtrl",
+				"+50ms      char down U+0068",
+				"+50ms      char up U+0068",
+				"+50ms      key down Enter",
+				"+50ms      key up Enter",
+				"+1.05s     mouse move 65535,0 absolute",
+				"+1.05s     mouse right down",
+				"+1.05s     mouse right up",
+			},
+			Passing: true,
+		},
+		{
+			Input: "tap Right; type \"😀\"; wheel -1; mousedown x1",
+			Output: []string{
+				"+0s        key down Right",
+				"+0s        key up Right",
+				"+0s        char down U+D83D",
+				"+0s        char up U+D83D",
+				"+0s        char down U+DE00",
+				"+0s        char up U+DE00",
+				"+0s        mouse wheel -120",
+				"+0s        mouse x1 down",
+			},
+			Passing: true,
+		},
+		{Input: "tap A\nmove 1,1", Output: "macro:2:1: move needs the screen size"},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			m, err := winapi.ParseMacro(s.Input.(string))
+			if err != nil {
+				t.Fatalf(test.ErrUnexpectedF, err)
+			}
+
+			width, height := int32(1920), int32(1080)
+			if !s.Passing {
+				width, height = 0, 0
+			}
+
+			var b strings.Builder
+			err = m.DryRun(&b, width, height)
+			if !s.Passing {
+				if err == nil || err.Error() != s.Output {
+					t.Errorf(test.ErrWantFGotF, s.Output, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf(test.ErrUnexpectedF, err)
+			}
+			if got := strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n"); !reflect.DeepEqual(got, s.Output) {
+				t.Errorf(test.ErrWantFGotF, s.Output, got)
+			}
+		})
+	}
+}
+
+// FuzzParseMacro checks that any macro that parses formats to a macro that
+// parses back to the same statements, and compiles.
+func FuzzParseMacro(f *testing.F) {
+	tName := "FuzzParseMacro"
+	if !enabled[tName] {
+		f.Skip(tName + test.TestsDisabled)
+	}
+
+	for _, seed := range []string{
+		`hold LCtrl; tap C; release LCtrl; wait 50ms; type "hello"; move 100,200; click left`,
+		"# comment\nmove by -3, 4\nwheel 2; hwheel -1; mousedown x2; mouseup x2",
+		`type "\x00é\U0001F600\t\r\n"; tap 0x41; tap F24`,
+		"wait 1h2m3.5s\n\n;;tap A",
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, src string) {
+		m, err := winapi.ParseMacro(src)
+		if err != nil {
+			var merr *winapi.MacroError
+			if !errors.As(err, &merr) || merr.Line < 1 || merr.Col < 1 {
+				t.Fatalf("error without position: %v", err)
+			}
+			return
+		}
+
+		m2, err := winapi.ParseMacro(m.String())
+		if err != nil {
+			t.Fatalf("formatted macro %q does not parse: %v", m.String(), err)
+		}
+		if len(m) != len(m2) {
+			t.Fatalf(test.ErrWantFGotF, len(m), len(m2))
+		}
+		for i := range m {
+			m[i].Line, m[i].Col, m2[i].Line, m2[i].Col = 0, 0, 0, 0
+			if m[i] != m2[i] {
+				t.Fatalf(test.ErrWantFGotF, m[i], m2[i])
+			}
+		}
+
+		if _, err := m.Compile(1920, 1080); err != nil {
+			t.Fatalf(test.ErrUnexpectedF, err)
+		}
+	})
+}
@@ -0,0 +1,95 @@
+package winapi
+
+// USER_DEFAULT_SCREEN_DPI is the DPI of a display at 100% scale, at which one
+// logical pixel is one physical pixel.
+const USER_DEFAULT_SCREEN_DPI = 96
+
+// A Monitor describes a display monitor. Its rectangles are in physical
+// pixels of the virtual screen, as a per-monitor DPI aware process sees them;
+// set the awareness with SetProcessDpiAwarenessContext before reading them.
+type Monitor struct {
+	// Handle is the HMONITOR of the monitor.
+	Handle Handle
+
+	// Name is the device name of the monitor, such as `\\.\DISPLAY1`.
+	Name string
+
+	// Bounds is the rectangle of the monitor on the virtual screen.
+	Bounds RECT
+
+	// WorkArea is the part of Bounds not covered by the taskbar and docked
+	// toolbars.
+	WorkArea RECT
+
+	// Primary is set for the primary monitor, whose upper-left corner is the
+	// origin of the virtual screen.
+	Primary bool
+
+	// DPI is the effective DPI of the monitor, USER_DEFAULT_SCREEN_DPI at
+	// 100% scale and 144 at 150%.
+	DPI uint32
+}
+
+// Scale returns the scale factor of m, such as 1.5 for 150%.
+func (m Monitor) Scale() float64 {
+	return float64(m.dpi()) / USER_DEFAULT_SCREEN_DPI
+}
+
+// LogicalToPhysical converts pt, in logical pixels relative to the upper-left
+// corner of m, to physical pixels of the virtual screen.
+func (m Monitor) LogicalToPhysical(pt POINT) POINT {
+	return POINT{
+		X: m.Bounds.Left + mulDiv(pt.X, int32(m.dpi()), USER_DEFAULT_SCREEN_DPI),
+		Y: m.Bounds.Top + mulDiv(pt.Y, int32(m.dpi()), USER_DEFAULT_SCREEN_DPI),
+	}
+}
+
+// PhysicalToLogical converts pt, in physical pixels of the virtual screen, to
+// logical pixels relative to the upper-left corner of m.
+func (m Monitor) PhysicalToLogical(pt POINT) POINT {
+	return POINT{
+		X: mulDiv(pt.X-m.Bounds.Left, USER_DEFAULT_SCREEN_DPI, int32(m.dpi())),
+		Y: mulDiv(pt.Y-m.Bounds.Top, USER_DEFAULT_SCREEN_DPI, int32(m.dpi())),
+	}
+}
+
+// dpi returns the DPI of m, or USER_DEFAULT_SCREEN_DPI if it is unknown.
+func (m Monitor) dpi() uint32 {
+	if m.DPI == 0 {
+		return USER_DEFAULT_SCREEN_DPI
+	}
+
+	return m.DPI
+}
+
+// NormalizePoint converts pt, in physical pixels of the virtual screen whose
+// bounds are virtual, to the normalized absolute coordinates of a MOUSEINPUT
+// sent with MOUSEEVENTF_ABSOLUTE and MOUSEEVENTF_VIRTUALDESK, from 0 to 65535.
+func NormalizePoint(pt POINT, virtual RECT) (x, y int32) {
+	return normalizeCoord(pt.X-virtual.Left, virtual.Width()), normalizeCoord(pt.Y-virtual.Top, virtual.Height())
+}
+
+// DenormalizePoint converts the normalized absolute coordinates x and y of a
+// MOUSEINPUT back to physical pixels of the virtual screen whose bounds are
+// virtual, as [NormalizePoint] does in reverse.
+func DenormalizePoint(x, y int32, virtual RECT) POINT {
+	return POINT{
+		X: virtual.Left + mulDiv(x, virtual.Width()-1, 65535),
+		Y: virtual.Top + mulDiv(y, virtual.Height()-1, 65535),
+	}
+}
+
+// mulDiv returns a*b/c rounded to the nearest integer, halves away from zero,
+// as MulDiv does.
+func mulDiv(a, b, c int32) int32 {
+	if c == 0 {
+		return -1
+	}
+
+	n, d := int64(a)*int64(b), int64(c)
+	if (n < 0) != (d < 0) {
+		return int32((n - d/2) / d)
+	}
+
+	return int32((n + d/2) / d)
+}
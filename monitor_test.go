@@ -0,0 +1,99 @@
+package winapi_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kamaranl/gotools/test"
+	"github.com/kamaranl/winapi"
+)
+
+func TestMonitorConversion(t *testing.T) {
+	tName := "MonitorConversion"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	// A 100% primary monitor with a 150% monitor to its right.
+	primary := winapi.Monitor{Bounds: winapi.RECT{Right: 1920, Bottom: 1080}, Primary: true}
+	secondary := winapi.Monitor{Bounds: winapi.RECT{Left: 1920, Top: -200, Right: 1920 + 3840, Bottom: 1960}, DPI: 144}
+
+	type conversion struct {
+		m       winapi.Monitor
+		logical winapi.POINT
+	}
+
+	scenes := []test.Scene{
+		{Input: conversion{primary, winapi.POINT{X: 100, Y: 200}}, Output: winapi.POINT{X: 100, Y: 200}},
+		{Input: conversion{secondary, winapi.POINT{X: 100, Y: 200}}, Output: winapi.POINT{X: 2070, Y: 100}},
+		{Input: conversion{secondary, winapi.POINT{X: 2559, Y: 1439}}, Output: winapi.POINT{X: 5759, Y: 1959}},
+		{Input: conversion{secondary, winapi.POINT{X: -10, Y: -1}}, Output: winapi.POINT{X: 1905, Y: -202}},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			c := s.Input.(conversion)
+
+			phys := c.m.LogicalToPhysical(c.logical)
+			if phys != s.Output.(winapi.POINT) {
+				t.Errorf(test.ErrWantFGotF, s.Output, phys)
+			}
+			if got := c.m.PhysicalToLogical(phys); got != c.logical {
+				t.Errorf(test.ErrWantFGotF, c.logical, got)
+			}
+		})
+	}
+
+	if got := secondary.Scale(); got != 1.5 {
+		t.Errorf(test.ErrWantFGotF, 1.5, got)
+	}
+	if got := primary.Scale(); got != 1 {
+		t.Errorf(test.ErrWantFGotF, 1, got)
+	}
+}
+
+func TestNormalizePoint(t *testing.T) {
+	tName := "NormalizePoint"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	// Two 1920x1080 monitors side by side, the primary one on the right.
+	virtual := winapi.RECT{Left: -1920, Right: 1920, Bottom: 1080}
+
+	scenes := []test.Scene{
+		{Input: winapi.POINT{X: -1920, Y: 0}, Output: [2]int32{0, 0}},
+		{Input: winapi.POINT{X: 1919, Y: 1079}, Output: [2]int32{65535, 65535}},
+		{Input: winapi.POINT{X: 0, Y: 540}, Output: [2]int32{32776, 32798}},
+		{Input: winapi.POINT{X: 960, Y: 270}, Output: [2]int32{49164, 16399}},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			pt := s.Input.(winapi.POINT)
+
+			x, y := winapi.NormalizePoint(pt, virtual)
+			if got := [2]int32{x, y}; got != s.Output.([2]int32) {
+				t.Errorf(test.ErrWantFGotF, s.Output, got)
+			}
+			if got := winapi.DenormalizePoint(x, y, virtual); got != pt {
+				t.Errorf(test.ErrWantFGotF, pt, got)
+			}
+		})
+	}
+
+	// A 1-pixel virtual screen has a single position.
+	pixel := winapi.RECT{Left: 5, Top: 5, Right: 6, Bottom: 6}
+	if x, y := winapi.NormalizePoint(winapi.POINT{X: 5, Y: 5}, pixel); x != 0 || y != 0 {
+		t.Errorf(test.ErrWantFGotF, [2]int32{0, 0}, [2]int32{x, y})
+	}
+	if got := winapi.DenormalizePoint(65535, 65535, pixel); got != (winapi.POINT{X: 5, Y: 5}) {
+		t.Errorf(test.ErrWantFGotF, winapi.POINT{X: 5, Y: 5}, got)
+	}
+
+	if r := (winapi.RECT{Left: -1920, Top: 0, Right: 1920, Bottom: 1080}); r != virtual ||
+		r.Width() != 3840 || r.Height() != 1080 ||
+		!r.Contains(winapi.POINT{X: -1920, Y: 1079}) || r.Contains(winapi.POINT{X: 1920, Y: 0}) {
+		t.Errorf(test.ErrWantFGotF, virtual, r)
+	}
+}
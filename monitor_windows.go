@@ -0,0 +1,109 @@
+//go:build windows
+
+package winapi
+
+import (
+	"sync"
+	"syscall"
+
+	"golang.org/x/sys/windows"
+)
+
+var (
+	// enumMonitorsMu serializes [Monitors], whose enumeration collects the
+	// monitors in enumMonitors.
+	enumMonitorsMu sync.Mutex
+	enumMonitors   []Handle
+
+	// enumMonitorsProc is the procedure of [EnumDisplayMonitors] for
+	// [Monitors]. A callback created by syscall.NewCallback is never freed,
+	// so it is created once.
+	enumMonitorsProc = syscall.NewCallback(func(hmon, hdc, rect, data uintptr) uintptr {
+		enumMonitors = append(enumMonitors, Handle(hmon))
+
+		return 1
+	})
+)
+
+// Monitors returns the display monitors of the virtual screen, in the order
+// EnumDisplayMonitors reports them.
+// It returns an error if the monitors cannot be enumerated or described.
+//
+// Experimental: Monitors has not been tested or used internally.
+func Monitors() ([]Monitor, error) {
+	enumMonitorsMu.Lock()
+	enumMonitors = nil
+	err := EnumDisplayMonitors(0, nil, enumMonitorsProc, 0)
+	handles := enumMonitors
+	enumMonitors = nil
+	enumMonitorsMu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	monitors := make([]Monitor, 0, len(handles))
+	for _, hmon := range handles {
+		m, err := monitorInfo(hmon)
+		if err != nil {
+			return nil, err
+		}
+		monitors = append(monitors, m)
+	}
+
+	return monitors, nil
+}
+
+// MonitorForWindow returns the display monitor that has the largest area of
+// intersection with the window hwnd, or the nearest one.
+// It returns an error if the monitor cannot be described.
+//
+// Experimental: MonitorForWindow has not been tested or used internally.
+func MonitorForWindow(hwnd HWND) (Monitor, error) {
+	return monitorInfo(MonitorFromWindow(hwnd, MONITOR_DEFAULTTONEAREST))
+}
+
+// MonitorForPoint returns the display monitor that contains pt, in screen
+// coordinates, or the nearest one.
+// It returns an error if the monitor cannot be described.
+//
+// Experimental: MonitorForPoint has not been tested or used internally.
+func MonitorForPoint(pt POINT) (Monitor, error) {
+	return monitorInfo(MonitorFromPoint(pt, MONITOR_DEFAULTTONEAREST))
+}
+
+// VirtualScreen returns the bounds of the virtual screen, the rectangle that
+// contains every monitor, for [NormalizePoint].
+//
+// Experimental: VirtualScreen has not been tested or used internally.
+func VirtualScreen() RECT {
+	left, top := GetSystemMetrics(SM_XVIRTUALSCREEN), GetSystemMetrics(SM_YVIRTUALSCREEN)
+
+	return RECT{
+		Left:   left,
+		Top:    top,
+		Right:  left + GetSystemMetrics(SM_CXVIRTUALSCREEN),
+		Bottom: top + GetSystemMetrics(SM_CYVIRTUALSCREEN),
+	}
+}
+
+// monitorInfo returns the [Monitor] of hmon. Its DPI is left at 0 where
+// GetDpiForMonitor is not supported.
+func monitorInfo(hmon Handle) (Monitor, error) {
+	var info MONITORINFOEXW
+	if err := GetMonitorInfoW(hmon, &info); err != nil {
+		return Monitor{}, err
+	}
+
+	m := Monitor{
+		Handle:   hmon,
+		Name:     windows.UTF16ToString(info.Device[:]),
+		Bounds:   info.Monitor,
+		WorkArea: info.Work,
+		Primary:  info.Flags&MONITORINFOF_PRIMARY != 0,
+	}
+	if dpi, _, err := GetDpiForMonitor(hmon, MDT_EFFECTIVE_DPI); err == nil {
+		m.DPI = dpi
+	}
+
+	return m, nil
+}
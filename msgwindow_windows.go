@@ -0,0 +1,165 @@
+//go:build windows
+
+package winapi
+
+import (
+	"runtime"
+	"sync"
+	"syscall"
+
+	"golang.org/x/sys/windows"
+)
+
+// msgWindowClass is the window class of the package's message-only windows.
+const msgWindowClass = "winapi.msgWindow"
+
+// A wndProcFunc handles a message sent or posted to a message-only window.
+// It returns the result of the message and true if it handled the message, or
+// false to have the default window procedure handle it.
+type wndProcFunc func(hwnd HWND, msg MsgId, wParam, lParam uintptr) (uintptr, bool)
+
+// A msgWindow is a message-only window running its message loop on a
+// dedicated locked thread.
+type msgWindow struct {
+	hwnd HWND
+	tid  uint32
+	done chan error
+}
+
+var (
+	msgWindowClassOnce sync.Once
+	msgWindowClassErr  error
+	msgWindowInstance  Handle
+
+	// msgWindowProcs maps the id of each window thread to its handler, since
+	// every window of the class shares the one callback.
+	msgWindowProcsMu sync.Mutex
+	msgWindowProcs   = map[uint32]wndProcFunc{}
+
+	msgWindowProc = syscall.NewCallback(func(hwnd, msg, wParam, lParam uintptr) uintptr {
+		msgWindowProcsMu.Lock()
+		fn := msgWindowProcs[windows.GetCurrentThreadId()]
+		msgWindowProcsMu.Unlock()
+
+		if fn != nil {
+			if r, ok := fn(HWND(hwnd), MsgId(msg), wParam, lParam); ok {
+				return r
+			}
+		}
+
+		return DefWindowProcW(HWND(hwnd), MsgId(msg), wParam, lParam)
+	})
+)
+
+// registerMsgWindowClass registers the window class of the package's
+// message-only windows, once per process.
+func registerMsgWindowClass() error {
+	msgWindowClassOnce.Do(func() {
+		name, err := syscall.UTF16PtrFromString(msgWindowClass)
+		if err != nil {
+			msgWindowClassErr = err
+			return
+		}
+
+		if err := windows.GetModuleHandleEx(0, nil, &msgWindowInstance); err != nil {
+			msgWindowClassErr = err
+			return
+		}
+
+		_, msgWindowClassErr = RegisterClassExW(&WNDCLASSEXW{
+			WndProc:   msgWindowProc,
+			Instance:  msgWindowInstance,
+			ClassName: name,
+		})
+	})
+
+	return msgWindowClassErr
+}
+
+// startMsgWindow creates a message-only window on a new locked thread, whose
+// messages are handled by fn. setup is called on that thread once the window
+// exists, and teardown before it is destroyed by close.
+// It returns an error if the window cannot be created or setup fails.
+func startMsgWindow(fn wndProcFunc, setup, teardown func(HWND) error) (*msgWindow, error) {
+	return startWindow(HWND_MESSAGE, fn, setup, teardown)
+}
+
+// startHiddenWindow is [startMsgWindow] for a hidden top-level window, which
+// unlike a message-only window receives broadcast messages such as
+// WM_DISPLAYCHANGE.
+func startHiddenWindow(fn wndProcFunc, setup, teardown func(HWND) error) (*msgWindow, error) {
+	return startWindow(0, fn, setup, teardown)
+}
+
+// startWindow creates a window of the package's class with the parent
+// HWND_MESSAGE or 0, for [startMsgWindow] and [startHiddenWindow].
+func startWindow(parent HWND, fn wndProcFunc, setup, teardown func(HWND) error) (*msgWindow, error) {
+	if err := registerMsgWindowClass(); err != nil {
+		return nil, err
+	}
+
+	w := &msgWindow{done: make(chan error, 1)}
+	ready := make(chan error, 1)
+
+	go func() {
+		runtime.LockOSThread()
+		defer runtime.UnlockOSThread()
+
+		w.tid = windows.GetCurrentThreadId()
+		msgWindowProcsMu.Lock()
+		msgWindowProcs[w.tid] = fn
+		msgWindowProcsMu.Unlock()
+		defer func() {
+			msgWindowProcsMu.Lock()
+			delete(msgWindowProcs, w.tid)
+			msgWindowProcsMu.Unlock()
+		}()
+
+		hwnd, err := CreateWindowExW(0, msgWindowClass, "", 0, 0, 0, 0, 0, parent, 0, msgWindowInstance, 0)
+		if err != nil {
+			ready <- err
+			return
+		}
+
+		if err := setup(hwnd); err != nil {
+			DestroyWindow(hwnd)
+			ready <- err
+			return
+		}
+
+		w.hwnd = hwnd
+		ready <- nil
+
+		var msg MSG
+		for {
+			if r1, err := getMessage(&msg, 0, 0, 0); r1 == 0 || err != nil {
+				break
+			}
+
+			dispatchMessage(&msg)
+		}
+
+		err = teardown(hwnd)
+		if derr := DestroyWindow(hwnd); err == nil {
+			err = derr
+		}
+
+		w.done <- err
+	}()
+
+	if err := <-ready; err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// close ends the message loop of w and destroys the window.
+// It returns the error of teardown or DestroyWindow, if any.
+func (w *msgWindow) close() error {
+	if err := PostThreadMessageW(w.tid, WM_QUIT, 0, 0); err != nil {
+		return err
+	}
+
+	return <-w.done
+}
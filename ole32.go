@@ -0,0 +1,91 @@
+//go:build windows
+
+package winapi
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+var (
+	ole32                = syscall.NewLazyDLL("ole32.dll")
+	procCoCreateInstance = ole32.NewProc("CoCreateInstance")
+	procCoInitializeEx   = ole32.NewProc("CoInitializeEx")
+	procCoUninitialize   = ole32.NewProc("CoUninitialize")
+)
+
+// COM constants used internally.
+const (
+	clsctxInprocServer     = 0x1
+	coinitApartmentThreads = 0x2
+	rpcEChangedMode        = 0x80010106
+)
+
+// comObject is a COM interface pointer. Its first word points to the
+// interface's vtable; the first three entries belong to IUnknown.
+type comObject struct {
+	vtbl *[64]uintptr
+}
+
+// call invokes the method at index method of o's vtable.
+// It returns the raw result, usually an HRESULT.
+func (o *comObject) call(method int, args ...uintptr) uintptr {
+	r1, _, _ := syscall.SyscallN(o.vtbl[method], append([]uintptr{uintptr(unsafe.Pointer(o))}, args...)...)
+	return r1
+}
+
+// queryInterface returns o's implementation of the interface iid.
+// It returns nil with an error if o does not implement it.
+func (o *comObject) queryInterface(iid *GUID) (*comObject, error) {
+	var obj *comObject
+	if err := hresult(o.call(0, uintptr(unsafe.Pointer(iid)), uintptr(unsafe.Pointer(&obj)))); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+// release decrements o's reference count.
+func (o *comObject) release() {
+	o.call(2)
+}
+
+// coCreateInstance creates an in-process instance of clsid and returns its
+// implementation of the interface iid.
+// It returns nil with an error if the call fails.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/combaseapi/nf-combaseapi-cocreateinstance
+func coCreateInstance(clsid, iid *GUID) (*comObject, error) {
+	var obj *comObject
+	r1, _, _ := procCoCreateInstance.Call(
+		uintptr(unsafe.Pointer(clsid)),
+		0,
+		clsctxInprocServer,
+		uintptr(unsafe.Pointer(iid)),
+		uintptr(unsafe.Pointer(&obj)),
+	)
+	if err := hresult(r1); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+// coInitialize initializes COM in a single-threaded apartment on the calling
+// thread, which must be locked with runtime.LockOSThread. If the thread
+// already uses another concurrency model, that model is kept.
+// It returns a function that uninitializes COM, or an error if the call fails.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/combaseapi/nf-combaseapi-coinitializeex
+func coInitialize() (func(), error) {
+	r1, _, _ := procCoInitializeEx.Call(0, coinitApartmentThreads)
+	if r1 == rpcEChangedMode {
+		return func() {}, nil
+	}
+
+	if err := hresult(r1); err != nil {
+		return nil, err
+	}
+
+	return func() { _, _, _ = procCoUninitialize.Call() }, nil
+}
@@ -0,0 +1,57 @@
+package winapi
+
+import (
+	"strings"
+	"syscall"
+	"unicode/utf16"
+)
+
+// EncodePathList encodes paths as a list of NUL-terminated UTF-16 strings
+// followed by an additional NUL, the format expected by the pFrom and pTo
+// members of SHFILEOPSTRUCTW and by other double-NUL-terminated lists.
+// It returns an error if paths is empty, or if any path is empty or contains a
+// NUL.
+func EncodePathList(paths ...string) ([]uint16, error) {
+	if len(paths) == 0 {
+		return nil, syscall.EINVAL
+	}
+
+	n := 1
+	for _, p := range paths {
+		if p == "" || strings.IndexByte(p, 0) != -1 {
+			return nil, syscall.EINVAL
+		}
+
+		n += len(p) + 1
+	}
+
+	list := make([]uint16, 0, n)
+	for _, p := range paths {
+		list = append(list, utf16.Encode([]rune(p))...)
+		list = append(list, 0)
+	}
+
+	return append(list, 0), nil
+}
+
+// DecodePathList decodes a double-NUL-terminated list of UTF-16 strings, as
+// produced by [EncodePathList]. Decoding stops at the first empty string or at
+// the end of list, whichever comes first.
+func DecodePathList(list []uint16) []string {
+	var paths []string
+	for len(list) > 0 && list[0] != 0 {
+		end := 0
+		for end < len(list) && list[end] != 0 {
+			end++
+		}
+
+		paths = append(paths, string(utf16.Decode(list[:end])))
+		if end == len(list) {
+			break
+		}
+
+		list = list[end+1:]
+	}
+
+	return paths
+}
@@ -0,0 +1,86 @@
+package winapi_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/kamaranl/gotools/test"
+	"github.com/kamaranl/winapi"
+)
+
+func TestEncodePathList(t *testing.T) {
+	tName := "EncodePathList"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	scenes := []test.Scene{
+		{
+			Input:   []string{`C:\a`},
+			Output:  []uint16{'C', ':', '\\', 'a', 0, 0},
+			Passing: true,
+		},
+		{
+			Input:   []string{`C:\a`, `D:\bc`},
+			Output:  []uint16{'C', ':', '\\', 'a', 0, 'D', ':', '\\', 'b', 'c', 0, 0},
+			Passing: true,
+		},
+		{
+			Input:   []string{`C:\😀`},
+			Output:  []uint16{'C', ':', '\\', 0xD83D, 0xDE00, 0, 0},
+			Passing: true,
+		},
+		{Input: []string{}, Output: []uint16(nil), Passing: false},
+		{Input: []string{`C:\a`, ""}, Output: []uint16(nil), Passing: false},
+		{Input: []string{"C:\\a\x00b"}, Output: []uint16(nil), Passing: false},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			got, err := winapi.EncodePathList(s.Input.([]string)...)
+			want := s.Output.([]uint16)
+
+			if (err == nil) != s.Passing {
+				t.Fatalf(test.ErrUnexpectedF, err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf(test.ErrWantFGotF, want, got)
+			}
+		})
+	}
+}
+
+func TestDecodePathList(t *testing.T) {
+	tName := "DecodePathList"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	scenes := []test.Scene{
+		{Input: []uint16{0, 0}, Output: []string(nil)},
+		{Input: []uint16{'a', 0, 'b', 'c', 0, 0}, Output: []string{"a", "bc"}},
+		{Input: []uint16{'a', 0, 'b'}, Output: []string{"a", "b"}},
+		{Input: []uint16{'a', 0, 0, 'b', 0, 0}, Output: []string{"a"}},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			got := winapi.DecodePathList(s.Input.([]uint16))
+			want := s.Output.([]string)
+
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf(test.ErrWantFGotF, want, got)
+			}
+		})
+	}
+
+	paths := []string{`C:\Users\me\file.txt`, `\\server\share\dir`, `C:\Ünïcödé\😀`}
+	list, err := winapi.EncodePathList(paths...)
+	if err != nil {
+		t.Fatalf(test.ErrUnexpectedF, err)
+	}
+	if got := winapi.DecodePathList(list); !reflect.DeepEqual(got, paths) {
+		t.Errorf(test.ErrWantFGotF, paths, got)
+	}
+}
@@ -0,0 +1,138 @@
+package winapi
+
+import (
+	"errors"
+	"math"
+)
+
+// ErrGridTooSmall is returned when tiling more windows than a [Grid] has
+// cells.
+var ErrGridTooSmall = errors.New("winapi: more windows than grid cells")
+
+// #region types
+
+// SWP represents the sizing and positioning flags of SetWindowPos.
+type SWP uint32
+
+// [SWP] constants.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-setwindowpos#parameters
+const (
+	SWP_NOSIZE         SWP = 0x0001
+	SWP_NOMOVE         SWP = 0x0002
+	SWP_NOZORDER       SWP = 0x0004
+	SWP_NOREDRAW       SWP = 0x0008
+	SWP_NOACTIVATE     SWP = 0x0010
+	SWP_FRAMECHANGED   SWP = 0x0020
+	SWP_SHOWWINDOW     SWP = 0x0040
+	SWP_HIDEWINDOW     SWP = 0x0080
+	SWP_NOCOPYBITS     SWP = 0x0100
+	SWP_NOOWNERZORDER  SWP = 0x0200
+	SWP_NOSENDCHANGING SWP = 0x0400
+	SWP_DEFERERASE     SWP = 0x2000
+	SWP_ASYNCWINDOWPOS SWP = 0x4000
+)
+
+// WPF represents the flags of a [WINDOWPLACEMENT].
+type WPF uint32
+
+// [WPF] constants.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-windowplacement#members
+const (
+	WPF_SETMINPOSITION       WPF = 0x0001
+	WPF_RESTORETOMAXIMIZED   WPF = 0x0002
+	WPF_ASYNCWINDOWPLACEMENT WPF = 0x0004
+)
+
+// A WINDOWPLACEMENT is a struct that contains the show state of a window and
+// its restored, minimized and maximized positions.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-windowplacement
+type WINDOWPLACEMENT struct {
+	// Length is the size of the struct, in bytes, which must be set before
+	// calling GetWindowPlacement or SetWindowPlacement.
+	Length uint32 // (UINT)
+
+	// Flags controls the minimized position and how the window is restored.
+	Flags WPF // (UINT)
+
+	// ShowCmd is the show state of the window.
+	ShowCmd SW // (UINT)
+
+	// MinPosition is the upper-left corner of the window when minimized.
+	MinPosition POINT
+
+	// MaxPosition is the upper-left corner of the window when maximized.
+	MaxPosition POINT
+
+	// NormalPosition is the rectangle of the window when restored, in
+	// workspace coordinates, which are relative to the work area of the
+	// primary monitor.
+	NormalPosition RECT
+}
+
+// A Grid divides an area into Rows by Cols cells of equal size, give or take
+// a pixel, which windows fill row by row.
+type Grid struct {
+	Rows, Cols int32
+}
+
+// GridFor returns the squarest [Grid] with room for n windows, with no more
+// rows than columns, such as 2x3 for 5 or 6 windows.
+func GridFor(n int) Grid {
+	if n <= 0 {
+		return Grid{}
+	}
+
+	cols := int32(math.Ceil(math.Sqrt(float64(n))))
+	rows := (int32(n) + cols - 1) / cols
+
+	return Grid{Rows: rows, Cols: cols}
+}
+
+// Len returns the number of cells of g.
+func (g Grid) Len() int {
+	return int(g.Rows) * int(g.Cols)
+}
+
+// Cell returns the i-th cell of g over area, counting row by row. Cells
+// share their edges, so that they cover area without gaps. A grid without
+// rows or columns has only empty cells.
+func (g Grid) Cell(area RECT, i int) RECT {
+	if g.Len() == 0 {
+		return RECT{}
+	}
+
+	row, col := int32(i)/g.Cols, int32(i)%g.Cols
+
+	return RECT{
+		Left:   area.Left + area.Width()*col/g.Cols,
+		Top:    area.Top + area.Height()*row/g.Rows,
+		Right:  area.Left + area.Width()*(col+1)/g.Cols,
+		Bottom: area.Top + area.Height()*(row+1)/g.Rows,
+	}
+}
+
+// CenterRect returns the rectangle of the given width and height centered in
+// area.
+func CenterRect(area RECT, width, height int32) RECT {
+	left := area.Left + (area.Width()-width)/2
+	top := area.Top + (area.Height()-height)/2
+
+	return RECT{Left: left, Top: top, Right: left + width, Bottom: top + height}
+}
+
+// #endregion
+
+// frameRect returns the window rectangle that puts the visible frame of a
+// window at target, given its current window rectangle and the bounds of its
+// frame, which leave out the invisible resize borders of Windows 10 and later.
+func frameRect(target, window, frame RECT) RECT {
+	return RECT{
+		Left:   target.Left - (frame.Left - window.Left),
+		Top:    target.Top - (frame.Top - window.Top),
+		Right:  target.Right + (window.Right - frame.Right),
+		Bottom: target.Bottom + (window.Bottom - frame.Bottom),
+	}
+}
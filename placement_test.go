@@ -0,0 +1,89 @@
+package winapi_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kamaranl/gotools/test"
+	"github.com/kamaranl/winapi"
+)
+
+func TestGridFor(t *testing.T) {
+	tName := "GridFor"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	scenes := []test.Scene{
+		{Input: 0, Output: winapi.Grid{}},
+		{Input: 1, Output: winapi.Grid{Rows: 1, Cols: 1}},
+		{Input: 2, Output: winapi.Grid{Rows: 1, Cols: 2}},
+		{Input: 3, Output: winapi.Grid{Rows: 2, Cols: 2}},
+		{Input: 5, Output: winapi.Grid{Rows: 2, Cols: 3}},
+		{Input: 12, Output: winapi.Grid{Rows: 3, Cols: 4}},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			if got := winapi.GridFor(s.Input.(int)); got != s.Output.(winapi.Grid) {
+				t.Errorf(test.ErrWantFGotF, s.Output, got)
+			}
+		})
+	}
+}
+
+func TestGridCell(t *testing.T) {
+	tName := "GridCell"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	// The work area of a monitor left of the primary one, above a taskbar.
+	area := winapi.RECT{Left: -1920, Top: 0, Right: 0, Bottom: 1040}
+	g := winapi.Grid{Rows: 3, Cols: 2}
+
+	scenes := []test.Scene{
+		{Input: 0, Output: winapi.RECT{Left: -1920, Top: 0, Right: -960, Bottom: 346}},
+		{Input: 1, Output: winapi.RECT{Left: -960, Top: 0, Right: 0, Bottom: 346}},
+		{Input: 2, Output: winapi.RECT{Left: -1920, Top: 346, Right: -960, Bottom: 693}},
+		{Input: 5, Output: winapi.RECT{Left: -960, Top: 693, Right: 0, Bottom: 1040}},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			if got := g.Cell(area, s.Input.(int)); got != s.Output.(winapi.RECT) {
+				t.Errorf(test.ErrWantFGotF, s.Output, got)
+			}
+		})
+	}
+
+	// A grid without rows or columns has no cells to divide area by.
+	for _, empty := range []winapi.Grid{{Rows: 1}, {Cols: 1}, {}} {
+		if got := empty.Cell(area, 0); got != (winapi.RECT{}) {
+			t.Errorf(test.ErrWantFGotF, winapi.RECT{}, got)
+		}
+	}
+
+	// Cells tile the area without gaps or overlaps.
+	var total int32
+	for i := range g.Len() {
+		c := g.Cell(area, i)
+		total += c.Width() * c.Height()
+	}
+	if want := area.Width() * area.Height(); total != want {
+		t.Errorf(test.ErrWantFGotF, want, total)
+	}
+}
+
+func TestCenterRect(t *testing.T) {
+	tName := "CenterRect"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	area := winapi.RECT{Left: 1920, Top: -200, Right: 5760, Bottom: 1960}
+	want := winapi.RECT{Left: 3240, Top: 480, Right: 4440, Bottom: 1280}
+	if got := winapi.CenterRect(area, 1200, 800); got != want {
+		t.Errorf(test.ErrWantFGotF, want, got)
+	}
+}
@@ -0,0 +1,151 @@
+//go:build windows
+
+package winapi
+
+import "unsafe"
+
+// WindowFrameBounds returns the visible frame of the window hwnd, in screen
+// coordinates, without the invisible resize borders that [GetWindowRect]
+// includes. It falls back to the window rectangle when desktop composition
+// does not report the frame.
+// It returns an error if the window rectangle cannot be retrieved.
+//
+// Experimental: WindowFrameBounds has not been tested or used internally.
+func WindowFrameBounds(hwnd HWND) (RECT, error) {
+	var r RECT
+	if err := DwmGetWindowAttribute(hwnd, DWMWA_EXTENDED_FRAME_BOUNDS, unsafe.Pointer(&r), uint32(unsafe.Sizeof(r))); err == nil {
+		return r, nil
+	}
+
+	return GetWindowRect(hwnd)
+}
+
+// PlaceWindow restores the window hwnd if it is minimized or maximized, and
+// moves its visible frame to r, in physical pixels of the virtual screen as
+// a per-monitor DPI aware process sees them. The Z order and the active
+// window are left unchanged.
+// It returns an error if the window cannot be moved.
+//
+// Experimental: PlaceWindow has not been tested or used internally.
+func PlaceWindow(hwnd HWND, r RECT) error {
+	if err := restoreWindow(hwnd); err != nil {
+		return err
+	}
+
+	window, err := GetWindowRect(hwnd)
+	if err != nil {
+		return err
+	}
+	frame, err := WindowFrameBounds(hwnd)
+	if err != nil {
+		return err
+	}
+
+	r = frameRect(r, window, frame)
+
+	return SetWindowPos(hwnd, HWND_TOP, r.Left, r.Top, r.Width(), r.Height(), SWP_NOZORDER|SWP_NOACTIVATE|SWP_NOOWNERZORDER)
+}
+
+// restoreWindow restores the window hwnd if it is minimized or maximized.
+func restoreWindow(hwnd HWND) error {
+	wp, err := GetWindowPlacement(hwnd)
+	if err != nil {
+		return err
+	}
+	if wp.ShowCmd == SW_SHOWMINIMIZED || wp.ShowCmd == SW_SHOWMAXIMIZED {
+		ShowWindow(hwnd, SW_RESTORE)
+	}
+
+	return nil
+}
+
+// Tile places windows in the cells of g over the work area of m, row by row.
+// A zero g is replaced by [GridFor] the number of windows.
+// It returns [ErrGridTooSmall] without moving any window if g has fewer
+// cells than there are windows, or the first error met placing a window;
+// the remaining windows are still placed.
+//
+// Experimental: Tile has not been tested or used internally.
+func Tile(windows []HWND, m Monitor, g Grid) error {
+	if g == (Grid{}) {
+		g = GridFor(len(windows))
+	}
+	if len(windows) > g.Len() {
+		return ErrGridTooSmall
+	}
+
+	var first error
+	for i, hwnd := range windows {
+		if err := PlaceWindow(hwnd, g.Cell(m.WorkArea, i)); err != nil && first == nil {
+			first = err
+		}
+	}
+
+	return first
+}
+
+// SnapLeft places the window hwnd in the left half of the work area of its
+// monitor, as Win+Left does.
+// It returns an error if the window cannot be moved.
+//
+// Experimental: SnapLeft has not been tested or used internally.
+func SnapLeft(hwnd HWND) error {
+	return snap(hwnd, 0)
+}
+
+// SnapRight places the window hwnd in the right half of the work area of its
+// monitor, as Win+Right does.
+// It returns an error if the window cannot be moved.
+//
+// Experimental: SnapRight has not been tested or used internally.
+func SnapRight(hwnd HWND) error {
+	return snap(hwnd, 1)
+}
+
+// snap places the window hwnd in the i-th half of the work area of its
+// monitor.
+func snap(hwnd HWND, i int) error {
+	m, err := MonitorForWindow(hwnd)
+	if err != nil {
+		return err
+	}
+
+	return PlaceWindow(hwnd, Grid{Rows: 1, Cols: 2}.Cell(m.WorkArea, i))
+}
+
+// Center restores the window hwnd if it is minimized or maximized, and moves
+// it, keeping its size, so that its visible frame is centered in the work
+// area of its monitor.
+// It returns an error if the window cannot be moved.
+//
+// Experimental: Center has not been tested or used internally.
+func Center(hwnd HWND) error {
+	if err := restoreWindow(hwnd); err != nil {
+		return err
+	}
+
+	m, err := MonitorForWindow(hwnd)
+	if err != nil {
+		return err
+	}
+	frame, err := WindowFrameBounds(hwnd)
+	if err != nil {
+		return err
+	}
+
+	return PlaceWindow(hwnd, CenterRect(m.WorkArea, frame.Width(), frame.Height()))
+}
+
+// SetTopmost places the window hwnd above all non-topmost windows, which it
+// stays above even when deactivated, or back among them if on is false.
+// It returns an error if the call fails.
+//
+// Experimental: SetTopmost has not been tested or used internally.
+func SetTopmost(hwnd HWND, on bool) error {
+	insertAfter := HWND_NOTOPMOST
+	if on {
+		insertAfter = HWND_TOPMOST
+	}
+
+	return SetWindowPos(hwnd, insertAfter, 0, 0, 0, 0, SWP_NOMOVE|SWP_NOSIZE|SWP_NOACTIVATE)
+}
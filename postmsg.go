@@ -0,0 +1,102 @@
+package winapi
+
+// A KeyLParam is the lParam of the keystroke messages WM_KEYDOWN, WM_KEYUP,
+// WM_SYSKEYDOWN and WM_SYSKEYUP, and of the WM_CHAR messages translated from
+// them.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/inputdev/about-keyboard-input#keystroke-message-flags
+type KeyLParam struct {
+	// Repeat is the number of times the keystroke is repeated by holding the
+	// key down, in bits 0-15.
+	Repeat uint16
+
+	// Scan is the scan code of the key, in bits 16-23.
+	Scan uint8
+
+	// Extended is set for an extended key, in bit 24.
+	Extended bool
+
+	// AltDown, the context code in bit 29, is set if Alt is down.
+	AltDown bool
+
+	// WasDown, the previous key state in bit 30, is set if the key was down
+	// before the message, which is always the case for a release.
+	WasDown bool
+
+	// Up, the transition state in bit 31, is set for a release.
+	Up bool
+}
+
+// NewKeyLParam returns the [KeyLParam] of a single press or release of the key
+// with the scan code sc, extended if it has an E0 prefix, such as 0xE04B for
+// Left.
+func NewKeyLParam(sc uint16, down bool) KeyLParam {
+	return KeyLParam{
+		Repeat:   1,
+		Scan:     uint8(sc),
+		Extended: sc>>8 == 0xE0,
+		WasDown:  !down,
+		Up:       !down,
+	}
+}
+
+// ParseKeyLParam returns the [KeyLParam] packed in lParam.
+func ParseKeyLParam(lParam uintptr) KeyLParam {
+	return KeyLParam{
+		Repeat:   uint16(lParam),
+		Scan:     uint8(lParam >> 16),
+		Extended: lParam&(1<<24) != 0,
+		AltDown:  lParam&(1<<29) != 0,
+		WasDown:  lParam&(1<<30) != 0,
+		Up:       lParam&(1<<31) != 0,
+	}
+}
+
+// LParam returns k packed into an lParam.
+func (k KeyLParam) LParam() uintptr {
+	lParam := uintptr(k.Repeat) | uintptr(k.Scan)<<16
+	for _, b := range []struct {
+		set bool
+		bit uintptr
+	}{
+		{k.Extended, 1 << 24},
+		{k.AltDown, 1 << 29},
+		{k.WasDown, 1 << 30},
+		{k.Up, 1 << 31},
+	} {
+		if b.set {
+			lParam |= b.bit
+		}
+	}
+
+	return lParam
+}
+
+// PointLParam returns the lParam of mouse messages for the client coordinates
+// pt: x in the low word and y in the high word, as signed 16-bit values.
+func PointLParam(pt POINT) uintptr {
+	return uintptr(uint16(int16(pt.X))) | uintptr(uint16(int16(pt.Y)))<<16
+}
+
+// ParsePointLParam returns the client coordinates packed in the lParam of a
+// mouse message, as GET_X_LPARAM and GET_Y_LPARAM do.
+func ParsePointLParam(lParam uintptr) POINT {
+	return POINT{X: int32(int16(lParam)), Y: int32(int16(lParam >> 16))}
+}
+
+// MouseWParam returns the wParam of the down or up message of the button b:
+// the [MK] flags of the buttons left down, which include b only on a press,
+// and for the X buttons, XBUTTON1 or XBUTTON2 in the high word.
+// It returns false if b is not one of the [MouseButton] constants.
+func MouseWParam(b MouseButton, down bool) (uintptr, bool) {
+	if int(b) >= len(mouseButtons) {
+		return 0, false
+	}
+
+	var wParam uintptr
+	if down {
+		wParam = uintptr(mouseButtons[b].mk)
+	}
+
+	return wParam | uintptr(mouseButtons[b].data)<<16, true
+}
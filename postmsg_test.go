@@ -0,0 +1,100 @@
+package winapi_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kamaranl/gotools/test"
+	"github.com/kamaranl/winapi"
+)
+
+func TestKeyLParam(t *testing.T) {
+	tName := "KeyLParam"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	scenes := []test.Scene{
+		{Input: winapi.NewKeyLParam(0x1E, true), Output: uintptr(0x001E0001)},
+		{Input: winapi.NewKeyLParam(0x1E, false), Output: uintptr(0xC01E0001)},
+		{Input: winapi.NewKeyLParam(0xE04B, true), Output: uintptr(0x014B0001)},
+		{Input: winapi.NewKeyLParam(0xE01D, false), Output: uintptr(0xC11D0001)},
+		{Input: winapi.KeyLParam{Repeat: 1, Scan: 0x38, AltDown: true}, Output: uintptr(0x20380001)},
+		{Input: winapi.KeyLParam{Repeat: 5, Scan: 0x21, AltDown: true, WasDown: true}, Output: uintptr(0x60210005)},
+		{Input: winapi.KeyLParam{}, Output: uintptr(0)},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			k := s.Input.(winapi.KeyLParam)
+			lParam := k.LParam()
+			if lParam != s.Output.(uintptr) {
+				t.Errorf(test.ErrWantFGotF, fmt.Sprintf("%#x", s.Output), fmt.Sprintf("%#x", lParam))
+			}
+			if got := winapi.ParseKeyLParam(lParam); got != k {
+				t.Errorf(test.ErrWantFGotF, k, got)
+			}
+		})
+	}
+}
+
+func TestPointLParam(t *testing.T) {
+	tName := "PointLParam"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	scenes := []test.Scene{
+		{Input: winapi.POINT{X: 10, Y: 20}, Output: uintptr(0x0014000A)},
+		{Input: winapi.POINT{X: -1, Y: -2}, Output: uintptr(0xFFFEFFFF)},
+		{Input: winapi.POINT{X: 32767, Y: -32768}, Output: uintptr(0x80007FFF)},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			pt := s.Input.(winapi.POINT)
+			lParam := winapi.PointLParam(pt)
+			if lParam != s.Output.(uintptr) {
+				t.Errorf(test.ErrWantFGotF, fmt.Sprintf("%#x", s.Output), fmt.Sprintf("%#x", lParam))
+			}
+			if got := winapi.ParsePointLParam(lParam); got != pt {
+				t.Errorf(test.ErrWantFGotF, pt, got)
+			}
+		})
+	}
+}
+
+func TestMouseWParam(t *testing.T) {
+	tName := "MouseWParam"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	type click struct {
+		b    winapi.MouseButton
+		down bool
+	}
+
+	scenes := []test.Scene{
+		{Input: click{winapi.MouseLeft, true}, Output: uintptr(winapi.MK_LBUTTON), Passing: true},
+		{Input: click{winapi.MouseLeft, false}, Output: uintptr(0), Passing: true},
+		{Input: click{winapi.MouseMiddle, true}, Output: uintptr(winapi.MK_MBUTTON), Passing: true},
+		{Input: click{winapi.MouseX1, true}, Output: uintptr(0x00010020), Passing: true},
+		{Input: click{winapi.MouseX2, false}, Output: uintptr(0x00020000), Passing: true},
+		{Input: click{winapi.MouseX2 + 1, true}, Output: uintptr(0)},
+		{Input: click{255, false}, Output: uintptr(0)},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			c := s.Input.(click)
+			got, ok := winapi.MouseWParam(c.b, c.down)
+			if got != s.Output.(uintptr) {
+				t.Errorf(test.ErrWantFGotF, fmt.Sprintf("%#x", s.Output), fmt.Sprintf("%#x", got))
+			}
+			if ok != s.Passing {
+				t.Errorf(test.ErrWantFGotF, s.Passing, ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+//go:build windows
+
+package winapi
+
+import (
+	"syscall"
+	"unicode/utf16"
+)
+
+// PostKey posts the press or release of the key vk to the window hwnd, with
+// the lParam of a single keystroke, without activating the window or changing
+// the keyboard state, which SendInput cannot do. If alt is set, the key is
+// posted as pressed or released while Alt is held, such as the F of Alt+F:
+// as WM_SYSKEYDOWN or WM_SYSKEYUP with the AltDown context code set, which
+// menus and accelerators act on. Alt itself and F10 are also posted as
+// WM_SYSKEYDOWN and WM_SYSKEYUP, other keys as WM_KEYDOWN and WM_KEYUP. The
+// window's own TranslateMessage turns the press into WM_CHAR, but programs
+// that read the keyboard state with GetKeyState do not see posted modifiers.
+// It returns the error of [PostMessageW].
+//
+// Experimental: PostKey has not been tested or used internally.
+func PostKey(hwnd HWND, vk uint16, down, alt bool) error {
+	msg := WM_KEYDOWN
+	lParam := NewKeyLParam(defaultKeyEncoder.ScanCode(vk), down)
+	switch {
+	case vk == VK_MENU || vk == VK_LMENU || vk == VK_RMENU:
+		msg, lParam.AltDown = WM_SYSKEYDOWN, down
+	case alt:
+		msg, lParam.AltDown = WM_SYSKEYDOWN, true
+	case vk == VK_F10:
+		msg = WM_SYSKEYDOWN
+	}
+	if !down {
+		msg++ // WM_KEYUP or WM_SYSKEYUP
+	}
+
+	return PostMessageW(hwnd, msg, uintptr(vk), lParam.LParam())
+}
+
+// PostChar posts WM_CHAR for the character r to the window hwnd, as a pair of
+// surrogates if r is outside the Basic Multilingual Plane. The lParam holds
+// the scan code of the key that types r with the layout of the calling
+// thread, if any.
+// It returns the error of [PostMessageW].
+//
+// Experimental: PostChar has not been tested or used internally.
+func PostChar(hwnd HWND, r rune) error {
+	var sc uint16
+	if r < 0x8000 {
+		if vk, _, err := VkKeyScanExW(int16(r), Handle(GetKeyboardLayout(0))); err == nil {
+			sc = defaultKeyEncoder.ScanCode(uint16(vk))
+		}
+	}
+	lParam := NewKeyLParam(sc, true).LParam()
+
+	for _, u := range utf16.AppendRune(nil, r) {
+		if err := PostMessageW(hwnd, WM_CHAR, uintptr(u), lParam); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PostText posts WM_CHAR for each character of s to the window hwnd, with line
+// breaks, "\n" or "\r\n", posted as the "\r" of Enter.
+// It returns the error of [PostChar], in which case the rest of s is not
+// posted.
+//
+// Experimental: PostText has not been tested or used internally.
+func PostText(hwnd HWND, s string) error {
+	prev := rune(0)
+	for _, r := range s {
+		switch {
+		case r == '\n' && prev == '\r':
+		case r == '\n':
+			if err := PostChar(hwnd, '\r'); err != nil {
+				return err
+			}
+		default:
+			if err := PostChar(hwnd, r); err != nil {
+				return err
+			}
+		}
+		prev = r
+	}
+
+	return nil
+}
+
+// PostClick posts a click of the button b at pt, in client coordinates, to
+// the window hwnd: WM_MOUSEMOVE, then the down and up messages of b, without
+// moving the cursor or activating the window.
+// It returns syscall.EINVAL if b is not one of the [MouseButton] constants, or
+// the error of [PostMessageW].
+//
+// Experimental: PostClick has not been tested or used internally.
+func PostClick(hwnd HWND, pt POINT, b MouseButton) error {
+	downWParam, ok := MouseWParam(b, true)
+	if !ok {
+		return syscall.EINVAL
+	}
+	upWParam, _ := MouseWParam(b, false)
+
+	down, up := WM_LBUTTONDOWN, WM_LBUTTONUP
+	switch b {
+	case MouseRight:
+		down, up = WM_RBUTTONDOWN, WM_RBUTTONUP
+	case MouseMiddle:
+		down, up = WM_MBUTTONDOWN, WM_MBUTTONUP
+	case MouseX1, MouseX2:
+		down, up = WM_XBUTTONDOWN, WM_XBUTTONUP
+	}
+
+	lParam := PointLParam(pt)
+	for _, m := range []struct {
+		msg    MsgId
+		wParam uintptr
+	}{
+		{WM_MOUSEMOVE, 0},
+		{down, downWParam},
+		{up, upWParam},
+	} {
+		if err := PostMessageW(hwnd, m.msg, m.wParam, lParam); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
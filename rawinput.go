@@ -0,0 +1,376 @@
+package winapi
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"unsafe"
+)
+
+// ErrRawInputFormat is returned when data is not a valid RAWINPUT struct.
+var ErrRawInputFormat = errors.New("winapi: invalid raw input")
+
+// #region types
+
+// RIM represents the type of a raw input device.
+type RIM uint32
+
+// [RIM] constants.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-rawinputheader#members
+const (
+	RIM_TYPEMOUSE RIM = iota
+	RIM_TYPEKEYBOARD
+	RIM_TYPEHID
+)
+
+// RIKey represents the scan code information flags of a [RAWKEYBOARD].
+type RIKey uint16
+
+// [RIKey] constants.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-rawkeyboard#members
+const (
+	RI_KEY_MAKE  RIKey = 0x00
+	RI_KEY_BREAK RIKey = 0x01
+	RI_KEY_E0    RIKey = 0x02
+	RI_KEY_E1    RIKey = 0x04
+)
+
+// MouseState represents the mouse state flags of a [RAWMOUSE].
+type MouseState uint16
+
+// [MouseState] constants.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-rawmouse#members
+const (
+	MOUSE_MOVE_RELATIVE      MouseState = 0x00
+	MOUSE_MOVE_ABSOLUTE      MouseState = 0x01
+	MOUSE_VIRTUAL_DESKTOP    MouseState = 0x02
+	MOUSE_ATTRIBUTES_CHANGED MouseState = 0x04
+	MOUSE_MOVE_NOCOALESCE    MouseState = 0x08
+)
+
+// RIMouse represents the button transition flags of a [RAWMOUSE].
+type RIMouse uint16
+
+// [RIMouse] constants.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-rawmouse#members
+const (
+	RI_MOUSE_LEFT_BUTTON_DOWN   RIMouse = 0x0001
+	RI_MOUSE_LEFT_BUTTON_UP     RIMouse = 0x0002
+	RI_MOUSE_RIGHT_BUTTON_DOWN  RIMouse = 0x0004
+	RI_MOUSE_RIGHT_BUTTON_UP    RIMouse = 0x0008
+	RI_MOUSE_MIDDLE_BUTTON_DOWN RIMouse = 0x0010
+	RI_MOUSE_MIDDLE_BUTTON_UP   RIMouse = 0x0020
+	RI_MOUSE_BUTTON_4_DOWN      RIMouse = 0x0040
+	RI_MOUSE_BUTTON_4_UP        RIMouse = 0x0080
+	RI_MOUSE_BUTTON_5_DOWN      RIMouse = 0x0100
+	RI_MOUSE_BUTTON_5_UP        RIMouse = 0x0200
+	RI_MOUSE_WHEEL              RIMouse = 0x0400
+	RI_MOUSE_HWHEEL             RIMouse = 0x0800
+)
+
+// HID usage pages and usages of the common top-level collections, for
+// registering raw input devices.
+//
+// See: https://learn.microsoft.com/en-us/windows-hardware/drivers/hid/hid-usages#usage-page
+const (
+	HID_USAGE_PAGE_GENERIC   = 0x01
+	HID_USAGE_GENERIC_MOUSE  = 0x02
+	HID_USAGE_GENERIC_KEYBRD = 0x06
+	HID_USAGE_GENERIC_KEYPAD = 0x07
+)
+
+// A RAWINPUTHEADER is a struct that contains the header information that is
+// part of the raw input data.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-rawinputheader
+type RAWINPUTHEADER struct {
+	// Type is the type of the device generating the input.
+	Type RIM // (DWORD)
+
+	// Size is the size, in bytes, of the entire input packet of data.
+	Size uint32 // (DWORD)
+
+	// Device is a handle to the device generating the input, as listed by
+	// GetRawInputDeviceList.
+	Device uintptr // (HANDLE)
+
+	// WParam is the value passed in the wParam of the WM_INPUT message.
+	WParam uintptr // (WPARAM)
+}
+
+// A RAWMOUSE is a struct that contains information about the state of the
+// mouse.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-rawmouse
+type RAWMOUSE struct {
+	// Flags is the mouse state.
+	Flags MouseState // (USHORT)
+
+	_ uint16 // padding
+
+	// ButtonFlags is the transition state of the mouse buttons.
+	ButtonFlags RIMouse // (USHORT)
+
+	// ButtonData is the signed wheel delta if ButtonFlags contains
+	// RI_MOUSE_WHEEL or RI_MOUSE_HWHEEL.
+	ButtonData uint16 // (USHORT)
+
+	// RawButtons is the raw state of the mouse buttons.
+	RawButtons uint32 // (ULONG)
+
+	// LastX is the motion in the X direction, or the absolute X coordinate if
+	// Flags contains MOUSE_MOVE_ABSOLUTE.
+	LastX int32 // (LONG)
+
+	// LastY is the motion in the Y direction, or the absolute Y coordinate if
+	// Flags contains MOUSE_MOVE_ABSOLUTE.
+	LastY int32 // (LONG)
+
+	// ExtraInformation is the device-specific additional information for the
+	// event.
+	ExtraInformation uint32 // (ULONG)
+}
+
+// A RAWKEYBOARD is a struct that contains information about the state of the
+// keyboard.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-rawkeyboard
+type RAWKEYBOARD struct {
+	// MakeCode is the scan code from the key depression.
+	MakeCode uint16 // (USHORT)
+
+	// Flags is the scan code information.
+	Flags RIKey // (USHORT)
+
+	// Reserved must be 0.
+	Reserved uint16 // (USHORT)
+
+	// VKey is the corresponding legacy virtual key code.
+	VKey uint16 // (USHORT)
+
+	// Message is the corresponding legacy keyboard window message, e.g.
+	// WM_KEYDOWN.
+	Message uint32 // (UINT)
+
+	// ExtraInformation is the device-specific additional information for the
+	// event.
+	ExtraInformation uint32 // (ULONG)
+}
+
+// A RAWHID is a struct that describes the format of the raw input from a
+// Human Interface Device (HID). Unlike the Win32 struct, which is followed by
+// its reports, RawData holds a copy of them.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-rawhid
+type RAWHID struct {
+	// SizeHid is the size, in bytes, of each HID input report.
+	SizeHid uint32 // (DWORD)
+
+	// Count is the number of HID input reports.
+	Count uint32 // (DWORD)
+
+	// RawData is the Count reports of SizeHid bytes each.
+	RawData []byte
+}
+
+// Report returns the i-th HID input report of h.
+func (h RAWHID) Report(i int) []byte {
+	return h.RawData[i*int(h.SizeHid) : (i+1)*int(h.SizeHid)]
+}
+
+// A RawInput is a decoded RAWINPUT struct. Only the member selected by
+// Header.Type is set.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-rawinput
+type RawInput struct {
+	Header   RAWINPUTHEADER
+	Mouse    RAWMOUSE
+	Keyboard RAWKEYBOARD
+	HID      RAWHID
+}
+
+// A RID_DEVICE_INFO is a struct that defines the raw input data coming from
+// any device, as retrieved with RIDI_DEVICEINFO. Use the method matching Type
+// to read the device-specific information.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-rid_device_info
+type RID_DEVICE_INFO struct {
+	// Size is the size, in bytes, of the struct.
+	Size uint32 // (DWORD)
+
+	// Type is the type of raw input data.
+	Type RIM // (DWORD)
+
+	info [24]byte // union of the device-specific structs
+}
+
+// A RID_DEVICE_INFO_MOUSE is a struct that defines the raw input data coming
+// from the specified mouse.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-rid_device_info_mouse
+type RID_DEVICE_INFO_MOUSE struct {
+	Id                 uint32 // (DWORD)
+	NumberOfButtons    uint32 // (DWORD)
+	SampleRate         uint32 // (DWORD)
+	HasHorizontalWheel int32  // (BOOL)
+}
+
+// A RID_DEVICE_INFO_KEYBOARD is a struct that defines the raw input data
+// coming from the specified keyboard.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-rid_device_info_keyboard
+type RID_DEVICE_INFO_KEYBOARD struct {
+	Type                 uint32 // (DWORD)
+	SubType              uint32 // (DWORD)
+	KeyboardMode         uint32 // (DWORD)
+	NumberOfFunctionKeys uint32 // (DWORD)
+	NumberOfIndicators   uint32 // (DWORD)
+	NumberOfKeysTotal    uint32 // (DWORD)
+}
+
+// A RID_DEVICE_INFO_HID is a struct that defines the raw input data coming
+// from the specified Human Interface Device (HID).
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-rid_device_info_hid
+type RID_DEVICE_INFO_HID struct {
+	VendorId      uint32 // (DWORD)
+	ProductId     uint32 // (DWORD)
+	VersionNumber uint32 // (DWORD)
+	UsagePage     uint16 // (USHORT)
+	Usage         uint16 // (USHORT)
+}
+
+// Mouse returns the mouse information of i, valid if Type is RIM_TYPEMOUSE.
+func (i *RID_DEVICE_INFO) Mouse() RID_DEVICE_INFO_MOUSE {
+	return *(*RID_DEVICE_INFO_MOUSE)(unsafe.Pointer(&i.info))
+}
+
+// Keyboard returns the keyboard information of i, valid if Type is
+// RIM_TYPEKEYBOARD.
+func (i *RID_DEVICE_INFO) Keyboard() RID_DEVICE_INFO_KEYBOARD {
+	return *(*RID_DEVICE_INFO_KEYBOARD)(unsafe.Pointer(&i.info))
+}
+
+// HID returns the HID information of i, valid if Type is RIM_TYPEHID.
+func (i *RID_DEVICE_INFO) HID() RID_DEVICE_INFO_HID {
+	return *(*RID_DEVICE_INFO_HID)(unsafe.Pointer(&i.info))
+}
+
+// #endregion
+
+// ptrSize is the size of a pointer, and so of the HANDLE and WPARAM members of
+// a RAWINPUTHEADER, in the raw input data of this process.
+const ptrSize = int(unsafe.Sizeof(uintptr(0)))
+
+// rawInputHeaderSize is the size of a RAWINPUTHEADER in the raw input data of
+// this process.
+const rawInputHeaderSize = 8 + 2*ptrSize
+
+// DecodeRawInput decodes a RAWINPUT struct, as retrieved by GetRawInputData
+// with RID_INPUT, in the layout of the running architecture.
+// It returns an error wrapping [ErrRawInputFormat] if data is malformed.
+func DecodeRawInput(data []byte) (RawInput, error) {
+	var ri RawInput
+	if len(data) < rawInputHeaderSize {
+		return ri, fmt.Errorf("%w: truncated header", ErrRawInputFormat)
+	}
+
+	ri.Header = RAWINPUTHEADER{
+		Type:   RIM(binary.LittleEndian.Uint32(data[0:])),
+		Size:   binary.LittleEndian.Uint32(data[4:]),
+		Device: uintptr(readPtr(data[8:])),
+		WParam: uintptr(readPtr(data[8+ptrSize:])),
+	}
+	if int(ri.Header.Size) < rawInputHeaderSize || int(ri.Header.Size) > len(data) {
+		return ri, fmt.Errorf("%w: bad size %d", ErrRawInputFormat, ri.Header.Size)
+	}
+
+	body := data[rawInputHeaderSize:ri.Header.Size]
+	switch ri.Header.Type {
+	case RIM_TYPEMOUSE:
+		if len(body) < 24 {
+			return ri, fmt.Errorf("%w: truncated mouse data", ErrRawInputFormat)
+		}
+
+		ri.Mouse = RAWMOUSE{
+			Flags:            MouseState(binary.LittleEndian.Uint16(body[0:])),
+			ButtonFlags:      RIMouse(binary.LittleEndian.Uint16(body[4:])),
+			ButtonData:       binary.LittleEndian.Uint16(body[6:]),
+			RawButtons:       binary.LittleEndian.Uint32(body[8:]),
+			LastX:            int32(binary.LittleEndian.Uint32(body[12:])),
+			LastY:            int32(binary.LittleEndian.Uint32(body[16:])),
+			ExtraInformation: binary.LittleEndian.Uint32(body[20:]),
+		}
+	case RIM_TYPEKEYBOARD:
+		if len(body) < 16 {
+			return ri, fmt.Errorf("%w: truncated keyboard data", ErrRawInputFormat)
+		}
+
+		ri.Keyboard = RAWKEYBOARD{
+			MakeCode:         binary.LittleEndian.Uint16(body[0:]),
+			Flags:            RIKey(binary.LittleEndian.Uint16(body[2:])),
+			Reserved:         binary.LittleEndian.Uint16(body[4:]),
+			VKey:             binary.LittleEndian.Uint16(body[6:]),
+			Message:          binary.LittleEndian.Uint32(body[8:]),
+			ExtraInformation: binary.LittleEndian.Uint32(body[12:]),
+		}
+	case RIM_TYPEHID:
+		if len(body) < 8 {
+			return ri, fmt.Errorf("%w: truncated HID data", ErrRawInputFormat)
+		}
+
+		size := binary.LittleEndian.Uint32(body[0:])
+		count := binary.LittleEndian.Uint32(body[4:])
+		if n := uint64(size) * uint64(count); n > uint64(len(body)-8) {
+			return ri, fmt.Errorf("%w: truncated HID reports", ErrRawInputFormat)
+		}
+
+		ri.HID = RAWHID{
+			SizeHid: size,
+			Count:   count,
+			RawData: append([]byte(nil), body[8:8+size*count]...),
+		}
+	default:
+		return ri, fmt.Errorf("%w: unknown type %d", ErrRawInputFormat, ri.Header.Type)
+	}
+
+	return ri, nil
+}
+
+// DecodeRawInputBuffer decodes the consecutive, pointer-aligned RAWINPUT
+// structs retrieved by GetRawInputBuffer, in the layout of the running
+// architecture.
+// It returns an error wrapping [ErrRawInputFormat] if data is malformed.
+func DecodeRawInputBuffer(data []byte) ([]RawInput, error) {
+	var out []RawInput
+	for len(data) > 0 {
+		ri, err := DecodeRawInput(data)
+		if err != nil {
+			return out, err
+		}
+
+		out = append(out, ri)
+
+		next := (int(ri.Header.Size) + ptrSize - 1) &^ (ptrSize - 1)
+		if next >= len(data) {
+			break
+		}
+
+		data = data[next:]
+	}
+
+	return out, nil
+}
+
+// readPtr reads a pointer-sized little-endian value.
+func readPtr(b []byte) uint64 {
+	if ptrSize == 8 {
+		return binary.LittleEndian.Uint64(b)
+	}
+
+	return uint64(binary.LittleEndian.Uint32(b))
+}
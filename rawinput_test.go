@@ -0,0 +1,167 @@
+package winapi_test
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"unsafe"
+
+	"github.com/kamaranl/gotools/test"
+	"github.com/kamaranl/winapi"
+)
+
+const ptrSize = int(unsafe.Sizeof(uintptr(0)))
+
+// rawInput builds a RAWINPUT struct in the layout of the running
+// architecture.
+func rawInput(typ winapi.RIM, device uintptr, body []byte) []byte {
+	hdr := make([]byte, 8+2*ptrSize)
+	binary.LittleEndian.PutUint32(hdr[0:], uint32(typ))
+	binary.LittleEndian.PutUint32(hdr[4:], uint32(len(hdr)+len(body)))
+	if ptrSize == 8 {
+		binary.LittleEndian.PutUint64(hdr[8:], uint64(device))
+	} else {
+		binary.LittleEndian.PutUint32(hdr[8:], uint32(device))
+	}
+
+	return append(hdr, body...)
+}
+
+func le(vals ...any) []byte {
+	var b []byte
+	for _, v := range vals {
+		b, _ = binary.Append(b, binary.LittleEndian, v)
+	}
+
+	return b
+}
+
+func TestDecodeRawInput(t *testing.T) {
+	tName := "DecodeRawInput"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	hdrSize := uint32(8 + 2*ptrSize)
+	kbd := rawInput(winapi.RIM_TYPEKEYBOARD, 0x1234, le(
+		uint16(0x1E), uint16(winapi.RI_KEY_BREAK), uint16(0), uint16(0x41), uint32(0x0101), uint32(7),
+	))
+	mouse := rawInput(winapi.RIM_TYPEMOUSE, 0x5678, le(
+		uint16(winapi.MOUSE_MOVE_RELATIVE), uint16(0),
+		uint16(winapi.RI_MOUSE_WHEEL), uint16(0xFF88),
+		uint32(0), int32(-3), int32(4), uint32(0),
+	))
+	hid := rawInput(winapi.RIM_TYPEHID, 0x9ABC, le(
+		uint32(3), uint32(2), []byte{1, 2, 3, 4, 5, 6},
+	))
+
+	scenes := []test.Scene{
+		{
+			Input: kbd,
+			Output: winapi.RawInput{
+				Header: winapi.RAWINPUTHEADER{Type: winapi.RIM_TYPEKEYBOARD, Size: hdrSize + 16, Device: 0x1234},
+				Keyboard: winapi.RAWKEYBOARD{
+					MakeCode: 0x1E, Flags: winapi.RI_KEY_BREAK, VKey: 0x41, Message: 0x0101, ExtraInformation: 7,
+				},
+			},
+			Passing: true,
+		},
+		{
+			Input: mouse,
+			Output: winapi.RawInput{
+				Header: winapi.RAWINPUTHEADER{Type: winapi.RIM_TYPEMOUSE, Size: hdrSize + 24, Device: 0x5678},
+				Mouse: winapi.RAWMOUSE{
+					ButtonFlags: winapi.RI_MOUSE_WHEEL, ButtonData: 0xFF88, LastX: -3, LastY: 4,
+				},
+			},
+			Passing: true,
+		},
+		{
+			Input: hid,
+			Output: winapi.RawInput{
+				Header: winapi.RAWINPUTHEADER{Type: winapi.RIM_TYPEHID, Size: hdrSize + 14, Device: 0x9ABC},
+				HID:    winapi.RAWHID{SizeHid: 3, Count: 2, RawData: []byte{1, 2, 3, 4, 5, 6}},
+			},
+			Passing: true,
+		},
+		{Input: kbd[:hdrSize-1], Passing: false},
+		{Input: kbd[:len(kbd)-1], Passing: false},
+		{Input: mouse[:hdrSize+8], Passing: false},
+		{Input: rawInput(winapi.RIM_TYPEHID, 0, le(uint32(4), uint32(2), []byte{1, 2, 3})), Passing: false},
+		{Input: rawInput(winapi.RIM_TYPEKEYBOARD, 0, le(uint32(0))), Passing: false},
+		{Input: rawInput(7, 0, nil), Passing: false},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			got, err := winapi.DecodeRawInput(s.Input.([]byte))
+			if !s.Passing {
+				if !errors.Is(err, winapi.ErrRawInputFormat) {
+					t.Errorf(test.ErrWantFGotF, winapi.ErrRawInputFormat, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf(test.ErrUnexpectedF, err)
+			}
+			if want := s.Output.(winapi.RawInput); !reflect.DeepEqual(got, want) {
+				t.Errorf(test.ErrWantFGotF, want, got)
+			}
+			if got.Header.Type == winapi.RIM_TYPEHID {
+				if r := got.HID.Report(1); !reflect.DeepEqual(r, []byte{4, 5, 6}) {
+					t.Errorf(test.ErrWantFGotF, []byte{4, 5, 6}, r)
+				}
+			}
+		})
+	}
+}
+
+func TestDecodeRawInputBuffer(t *testing.T) {
+	tName := "DecodeRawInputBuffer"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	kbd := rawInput(winapi.RIM_TYPEKEYBOARD, 1, make([]byte, 16))
+	hid := rawInput(winapi.RIM_TYPEHID, 2, le(uint32(1), uint32(1), []byte{9}))
+	pad := func(b []byte) []byte {
+		for len(b)%ptrSize != 0 {
+			b = append(b, 0)
+		}
+		return b
+	}
+
+	var buf []byte
+	buf = append(buf, pad(hid)...)
+	buf = append(buf, pad(kbd)...)
+	buf = append(buf, hid...)
+
+	scenes := []test.Scene{
+		{Input: buf, Output: []uintptr{2, 1, 2}, Passing: true},
+		{Input: []byte{}, Output: []uintptr(nil), Passing: true},
+		{Input: buf[:len(buf)-1], Output: []uintptr{2, 1}, Passing: false},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			got, err := winapi.DecodeRawInputBuffer(s.Input.([]byte))
+			if s.Passing && err != nil {
+				t.Fatalf(test.ErrUnexpectedF, err)
+			}
+			if !s.Passing && !errors.Is(err, winapi.ErrRawInputFormat) {
+				t.Errorf(test.ErrWantFGotF, winapi.ErrRawInputFormat, err)
+			}
+
+			var devices []uintptr
+			for _, ri := range got {
+				devices = append(devices, ri.Header.Device)
+			}
+			if want := s.Output.([]uintptr); !reflect.DeepEqual(devices, want) {
+				t.Errorf(test.ErrWantFGotF, want, devices)
+			}
+		})
+	}
+}
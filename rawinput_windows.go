@@ -0,0 +1,159 @@
+//go:build windows
+
+package winapi
+
+import (
+	"io"
+	"sync"
+	"syscall"
+	"unsafe"
+)
+
+// RawInputDeviceName retrieves the device interface path of the raw input
+// device, which identifies the physical device, e.g. to tell a barcode scanner
+// from a keyboard.
+// It returns an empty string with an error if the call fails.
+//
+// Experimental: RawInputDeviceName has not been tested or used internally.
+func RawInputDeviceName(device Handle) (string, error) {
+	var n uint32
+	if _, err := GetRawInputDeviceInfoW(device, RIDI_DEVICENAME, nil, &n); err != nil {
+		return "", err
+	}
+	if n == 0 {
+		return "", nil
+	}
+
+	buf := make([]uint16, n)
+	if _, err := GetRawInputDeviceInfoW(device, RIDI_DEVICENAME, unsafe.Pointer(&buf[0]), &n); err != nil {
+		return "", err
+	}
+
+	return syscall.UTF16ToString(buf), nil
+}
+
+// RawInputDeviceInfo retrieves the type and device-specific information of the
+// raw input device.
+// It returns an error if the call fails.
+//
+// Experimental: RawInputDeviceInfo has not been tested or used internally.
+func RawInputDeviceInfo(device Handle) (RID_DEVICE_INFO, error) {
+	var info RID_DEVICE_INFO
+	info.Size = uint32(unsafe.Sizeof(info))
+	n := info.Size
+	if _, err := GetRawInputDeviceInfoW(device, RIDI_DEVICEINFO, unsafe.Pointer(&info), &n); err != nil {
+		return RID_DEVICE_INFO{}, err
+	}
+
+	return info, nil
+}
+
+// A RawInputReader receives the raw input of the devices it was opened for
+// through a message-only window, and yields it as decoded events tagged by
+// device handle. Raw input registration is per process and per top level
+// collection, so only one RawInputReader should be open for a given usage at
+// a time.
+type RawInputReader struct {
+	win       *msgWindow
+	devices   []RAWINPUTDEVICE
+	events    chan RawInput
+	closed    chan struct{}
+	closeOnce sync.Once
+	closeErr  error
+}
+
+// NewRawInputReader opens a [RawInputReader] for devices, typically
+// identified by HID_USAGE_PAGE_GENERIC and HID_USAGE_GENERIC_KEYBRD or
+// HID_USAGE_GENERIC_MOUSE. The Target of each device is set to the reader's
+// window, and RIDEV_INPUTSINK is added so that input is received whichever
+// window has the focus.
+// It returns nil with an error if the devices cannot be registered.
+//
+// Experimental: NewRawInputReader has not been tested or used internally.
+func NewRawInputReader(devices ...RAWINPUTDEVICE) (*RawInputReader, error) {
+	r := &RawInputReader{
+		devices: append([]RAWINPUTDEVICE(nil), devices...),
+		events:  make(chan RawInput, 64),
+		closed:  make(chan struct{}),
+	}
+
+	var buf []byte
+	win, err := startMsgWindow(
+		func(hwnd HWND, msg MsgId, wParam, lParam uintptr) (uintptr, bool) {
+			if msg != WM_INPUT {
+				return 0, false
+			}
+
+			var n uint32
+			if _, err := GetRawInputData(Handle(lParam), RID_INPUT, nil, &n); err != nil || n == 0 {
+				return 0, false
+			}
+			if int(n) > len(buf) {
+				buf = make([]byte, n)
+			}
+			if _, err := GetRawInputData(Handle(lParam), RID_INPUT, unsafe.Pointer(&buf[0]), &n); err != nil {
+				return 0, false
+			}
+
+			if ri, err := DecodeRawInput(buf[:n]); err == nil {
+				select {
+				case r.events <- ri:
+				case <-r.closed:
+				}
+			}
+
+			// The default window procedure cleans up after WM_INPUT.
+			return 0, false
+		},
+		func(hwnd HWND) error {
+			for i := range r.devices {
+				r.devices[i].Target = hwnd
+				r.devices[i].Flags |= RIDEV_INPUTSINK
+			}
+
+			return RegisterRawInputDevices(r.devices)
+		},
+		func(HWND) error {
+			for i := range r.devices {
+				r.devices[i].Target = 0
+				r.devices[i].Flags = RIDEV_REMOVE
+			}
+
+			return RegisterRawInputDevices(r.devices)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.win = win
+	return r, nil
+}
+
+// Read blocks until the next raw input event is received. The device that
+// generated it is identified by its Header.Device.
+// It returns [io.EOF] once the reader is closed.
+//
+// Experimental: Read has not been tested or used internally.
+func (r *RawInputReader) Read() (RawInput, error) {
+	select {
+	case ri := <-r.events:
+		return ri, nil
+	case <-r.closed:
+		return RawInput{}, io.EOF
+	}
+}
+
+// Close unregisters the devices of the reader and destroys its window. Pending
+// and subsequent calls to Read return [io.EOF].
+// It returns an error if the devices cannot be unregistered.
+//
+// Experimental: Close has not been tested or used internally.
+func (r *RawInputReader) Close() error {
+	r.closeOnce.Do(func() {
+		close(r.closed)
+		r.closeErr = r.win.close()
+	})
+
+	return r.closeErr
+}
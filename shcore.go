@@ -0,0 +1,40 @@
+//go:build windows
+
+package winapi
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+var (
+	shcore               = syscall.NewLazyDLL("shcore.dll")
+	procGetDpiForMonitor = shcore.NewProc("GetDpiForMonitor")
+)
+
+// GetDpiForMonitor retrieves the horizontal and vertical DPI of the display
+// monitor hmon, of the type dpiType. The effective DPI, which Windows scales
+// by, is the same for both axes.
+// It returns an error if the call fails or is not supported (Windows 8.1 and
+// later).
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/shellscalingapi/nf-shellscalingapi-getdpiformonitor
+//
+// Experimental: GetDpiForMonitor has not been tested or used internally.
+func GetDpiForMonitor(hmon Handle, dpiType MDT) (dpiX, dpiY uint32, err error) {
+	if err := procGetDpiForMonitor.Find(); err != nil {
+		return 0, 0, err
+	}
+
+	hr, _, _ := procGetDpiForMonitor.Call(
+		uintptr(hmon),
+		uintptr(dpiType),
+		uintptr(unsafe.Pointer(&dpiX)),
+		uintptr(unsafe.Pointer(&dpiY)),
+	)
+	if err := hresult(hr); err != nil {
+		return 0, 0, err
+	}
+
+	return dpiX, dpiY, nil
+}
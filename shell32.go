@@ -2,13 +2,165 @@
 
 package winapi
 
-import "syscall"
+import (
+	"fmt"
+	"path/filepath"
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
 
 var (
-	shell32            = syscall.NewLazyDLL("shell32.dll")
-	procSHChangeNotify = shell32.NewProc("SHChangeNotify")
+	shell32                  = syscall.NewLazyDLL("shell32.dll")
+	procSHChangeNotify       = shell32.NewProc("SHChangeNotify")
+	procSHEmptyRecycleBinW   = shell32.NewProc("SHEmptyRecycleBinW")
+	procSHFileOperationW     = shell32.NewProc("SHFileOperationW")
+	procSHGetFileInfoW       = shell32.NewProc("SHGetFileInfoW")
+	procSHGetImageList       = shell32.NewProc("SHGetImageList")
+	procSHGetKnownFolderPath = shell32.NewProc("SHGetKnownFolderPath")
+	procSHQueryRecycleBinW   = shell32.NewProc("SHQueryRecycleBinW")
+	procSHSetKnownFolderPath = shell32.NewProc("SHSetKnownFolderPath")
+	procShellExecuteExW      = shell32.NewProc("ShellExecuteExW")
 )
 
+// ShellExecuteOptions describes an operation performed by [ShellExecute].
+type ShellExecuteOptions struct {
+	// Verb is the action to perform, e.g. "open", "runas", "print" or
+	// "properties". An empty Verb uses the default action for File.
+	Verb string
+
+	// File is the file, folder, URL or executable to act on.
+	File string
+
+	// Args are the command-line parameters passed to File, if it is an
+	// executable.
+	Args string
+
+	// Dir is the working directory. An empty Dir uses the current directory.
+	Dir string
+
+	// Show specifies how the application window is shown. The zero value,
+	// which is SW_HIDE, shows it with SW_SHOWNORMAL; set Hidden to start a
+	// program hidden.
+	Show SW
+
+	// Hidden starts the application window hidden, with SW_HIDE, whatever
+	// Show is.
+	Hidden bool
+
+	// Wait makes ShellExecute wait for the started process to exit.
+	Wait bool
+
+	// Hwnd is the parent window for any UI shown, such as the UAC prompt.
+	Hwnd HWND
+}
+
+// EmptyRecycleBin empties the Recycle Bin on the drive that contains root, e.g.
+// `C:\`. An empty root empties the Recycle Bins on all drives.
+// It returns an error if the call fails.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/shellapi/nf-shellapi-shemptyrecyclebinw
+//
+// Experimental: EmptyRecycleBin has not been tested or used internally.
+func EmptyRecycleBin(root string, flags SHERB) error {
+	p, err := optUTF16Ptr(root)
+	if err != nil {
+		return err
+	}
+
+	r1, _, _ := procSHEmptyRecycleBinW.Call(
+		0,
+		uintptr(unsafe.Pointer(p)),
+		uintptr(flags),
+	)
+
+	return hresult(r1)
+}
+
+// KnownFolderPath retrieves the full path of the known folder with the
+// provided canonical or FOLDERID_ name (see [KnownFolderByName]) for the
+// current user.
+// It returns an error if the name is unknown or the call fails.
+//
+// Experimental: KnownFolderPath has not been tested or used internally.
+func KnownFolderPath(name string, flags KFFlag) (string, error) {
+	id, ok := KnownFolderByName(name)
+	if !ok {
+		return "", fmt.Errorf("%w: unknown known folder %q", syscall.EINVAL, name)
+	}
+
+	return SHGetKnownFolderPath(id, flags, 0)
+}
+
+// MoveToRecycleBin sends the provided files and directories to the Recycle Bin
+// without asking for confirmation. Relative paths are resolved against the
+// current directory. If an item cannot be recycled (e.g. it is too large or on
+// a drive without a Recycle Bin), the user is warned before it is deleted
+// permanently.
+// It returns an error if the operation fails, or windows.ERROR_CANCELLED if the
+// user aborted it.
+//
+// Experimental: MoveToRecycleBin has not been tested or used internally.
+func MoveToRecycleBin(paths ...string) error {
+	abs := make([]string, len(paths))
+	for i, p := range paths {
+		var err error
+		if abs[i], err = filepath.Abs(p); err != nil {
+			return err
+		}
+	}
+
+	from, err := EncodePathList(abs...)
+	if err != nil {
+		return err
+	}
+
+	op := shFileOpStruct{
+		wFunc:  FO_DELETE,
+		pFrom:  &from[0],
+		fFlags: FOF_ALLOWUNDO | FOF_NOCONFIRMATION | FOF_SILENT | FOF_NOERRORUI | FOF_WANTNUKEWARNING,
+	}
+
+	// SHFileOperationW returns its own error codes rather than setting the
+	// last error; most of them match system error codes.
+	if r1, _, _ := procSHFileOperationW.Call(uintptr(unsafe.Pointer(&op))); r1 != 0 {
+		return syscall.Errno(r1)
+	}
+
+	if op.aborted() {
+		return windows.ERROR_CANCELLED
+	}
+
+	return nil
+}
+
+// QueryRecycleBin retrieves the number of items in, and the total size in bytes
+// of, the Recycle Bin on the drive that contains root, e.g. `C:\`. An empty root
+// queries the Recycle Bins on all drives.
+// It returns an error if the call fails.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/shellapi/nf-shellapi-shqueryrecyclebinw
+//
+// Experimental: QueryRecycleBin has not been tested or used internally.
+func QueryRecycleBin(root string) (items int64, size int64, err error) {
+	p, err := optUTF16Ptr(root)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	info := SHQUERYRBINFO{CbSize: uint32(unsafe.Sizeof(SHQUERYRBINFO{}))}
+	r1, _, _ := procSHQueryRecycleBinW.Call(
+		uintptr(unsafe.Pointer(p)),
+		uintptr(unsafe.Pointer(&info)),
+	)
+	if err := hresult(r1); err != nil {
+		return 0, 0, err
+	}
+
+	return info.NumItems, info.Size, nil
+}
+
 // SHChangeNotify notifies the system of an event, by eventId, that an
 // application has performed.
 //
@@ -30,3 +182,186 @@ func SHChangeNotify(eventId SHCNEvent, flags SHCNFlags, items ...uintptr) {
 		uintptr(items[1]),
 	)
 }
+
+// SHGetFileInfoW retrieves information about the file object at path into
+// info, as selected by flags. With SHGFI_USEFILEATTRIBUTES, path need not
+// exist and attrs is used as its file attributes.
+// It returns 0 with an error if the call fails, or a value that depends on
+// flags (e.g. the system image list for SHGFI_SYSICONINDEX) on success.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/shellapi/nf-shellapi-shgetfileinfow
+//
+// Experimental: SHGetFileInfoW has not been tested or used internally.
+func SHGetFileInfoW(path string, attrs uint32, info *SHFILEINFOW, flags SHGFI) (uintptr, error) {
+	p, err := syscall.UTF16PtrFromString(path)
+	if err != nil {
+		return 0, err
+	}
+
+	r1, _, _ := procSHGetFileInfoW.Call(
+		uintptr(unsafe.Pointer(p)),
+		uintptr(attrs),
+		uintptr(unsafe.Pointer(info)),
+		unsafe.Sizeof(*info),
+		uintptr(flags),
+	)
+	if r1 == 0 {
+		return 0, syscall.EINVAL
+	}
+
+	return r1, nil
+}
+
+// SHGetKnownFolderPath retrieves the full path of the known folder identified
+// by rfid. A token of 0 requests the folder of the current user.
+// It returns an empty string with an error if the call fails, or the path with
+// no error on success.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/shlobj_core/nf-shlobj_core-shgetknownfolderpath
+//
+// Experimental: SHGetKnownFolderPath has not been tested or used internally.
+func SHGetKnownFolderPath(rfid *KNOWNFOLDERID, flags KFFlag, token Handle) (string, error) {
+	var path *uint16
+	r1, _, _ := procSHGetKnownFolderPath.Call(
+		uintptr(unsafe.Pointer(rfid)),
+		uintptr(flags),
+		uintptr(token),
+		uintptr(unsafe.Pointer(&path)),
+	)
+	defer windows.CoTaskMemFree(unsafe.Pointer(path))
+
+	if err := hresult(r1); err != nil {
+		return "", err
+	}
+
+	return windows.UTF16PtrToString(path), nil
+}
+
+// SHSetKnownFolderPath redirects the known folder identified by rfid to path.
+// A token of 0 redirects the folder of the current user.
+// It returns an error if the call fails.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/shlobj_core/nf-shlobj_core-shsetknownfolderpath
+//
+// Experimental: SHSetKnownFolderPath has not been tested or used internally.
+func SHSetKnownFolderPath(rfid *KNOWNFOLDERID, flags KFFlag, token Handle, path string) error {
+	p, err := syscall.UTF16PtrFromString(path)
+	if err != nil {
+		return err
+	}
+
+	r1, _, _ := procSHSetKnownFolderPath.Call(
+		uintptr(unsafe.Pointer(rfid)),
+		uintptr(flags),
+		uintptr(token),
+		uintptr(unsafe.Pointer(p)),
+	)
+
+	return hresult(r1)
+}
+
+// ShellExecute performs the operation described by opts through
+// [ShellExecuteExW]. Use the "runas" verb to start File elevated.
+// If opts.Wait is false, it returns a handle to the started process, which the
+// caller must close with windows.CloseHandle, or 0 if no new process was
+// started (e.g. the file was opened in an already running instance). If
+// opts.Wait is true, it closes the handle itself and returns the process's
+// exit code instead.
+// It returns an error if the call fails. A declined UAC prompt is reported as
+// windows.ERROR_CANCELLED.
+//
+// Experimental: ShellExecute has not been tested or used internally.
+func ShellExecute(opts ShellExecuteOptions) (process Handle, exitCode uint32, err error) {
+	info := SHELLEXECUTEINFOW{
+		Mask: SEE_MASK_NOCLOSEPROCESS | SEE_MASK_NOASYNC | SEE_MASK_UNICODE,
+		Hwnd: opts.Hwnd,
+		Show: opts.Show,
+	}
+	switch {
+	case opts.Hidden:
+		info.Show = SW_HIDE
+	case info.Show == 0:
+		info.Show = SW_SHOWNORMAL
+	}
+	for _, f := range []struct {
+		dst **uint16
+		src string
+	}{
+		{&info.Verb, opts.Verb},
+		{&info.File, opts.File},
+		{&info.Parameters, opts.Args},
+		{&info.Directory, opts.Dir},
+	} {
+		if f.src == "" {
+			continue
+		}
+
+		if *f.dst, err = syscall.UTF16PtrFromString(f.src); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	if err = ShellExecuteExW(&info); err != nil {
+		return 0, 0, err
+	}
+
+	if !opts.Wait || info.Process == 0 {
+		return info.Process, 0, nil
+	}
+
+	defer windows.CloseHandle(info.Process)
+
+	if _, err = windows.WaitForSingleObject(info.Process, windows.INFINITE); err != nil {
+		return 0, 0, err
+	}
+
+	if err = windows.GetExitCodeProcess(info.Process, &exitCode); err != nil {
+		return 0, 0, err
+	}
+
+	return 0, exitCode, nil
+}
+
+// ShellExecuteExW performs an operation on a file as described by info. The
+// CbSize of info is filled in automatically.
+// It returns an error if the call fails. If the system does not report a
+// specific error, the [SEErr] stored in info.InstApp is returned.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/shellapi/nf-shellapi-shellexecuteexw
+//
+// Experimental: ShellExecuteExW has not been tested or used internally.
+func ShellExecuteExW(info *SHELLEXECUTEINFOW) error {
+	info.CbSize = uint32(unsafe.Sizeof(*info))
+	if r1, _, err := procShellExecuteExW.Call(uintptr(unsafe.Pointer(info))); r1 == 0 {
+		if err != syscall.Errno(0) {
+			return err
+		}
+
+		if info.InstApp <= 32 {
+			return SEErr(info.InstApp)
+		}
+
+		return syscall.EINVAL
+	}
+
+	return nil
+}
+
+// shGetImageList retrieves the IImageList interface of the system image list
+// for the icon size list.
+// It returns nil with an error if the call fails.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/shellapi/nf-shellapi-shgetimagelist
+func shGetImageList(list SHIL) (*comObject, error) {
+	var il *comObject
+	r1, _, _ := procSHGetImageList.Call(
+		uintptr(list),
+		uintptr(unsafe.Pointer(&iidIImageList)),
+		uintptr(unsafe.Pointer(&il)),
+	)
+	if err := hresult(r1); err != nil {
+		return nil, err
+	}
+
+	return il, nil
+}
@@ -0,0 +1,39 @@
+//go:build windows
+
+package winapi_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/kamaranl/gotools/test"
+	"github.com/kamaranl/winapi"
+	"golang.org/x/sys/windows"
+)
+
+func TestSEErr(t *testing.T) {
+	tName := "SEErr"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	scenes := []test.Scene{
+		{Input: winapi.SEErr(0), Output: windows.ERROR_NOT_ENOUGH_MEMORY},
+		{Input: winapi.SE_ERR_FNF, Output: windows.ERROR_FILE_NOT_FOUND},
+		{Input: winapi.SE_ERR_ACCESSDENIED, Output: windows.ERROR_ACCESS_DENIED},
+		{Input: winapi.SE_ERR_NOASSOC, Output: windows.ERROR_NO_ASSOCIATION},
+		{Input: winapi.SE_ERR_DDEBUSY, Output: windows.ERROR_DDE_FAIL},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			got := s.Input.(winapi.SEErr)
+			want := s.Output.(windows.Errno)
+
+			if !errors.Is(got, want) {
+				t.Errorf(test.ErrWantFGotF, want, got.Errno())
+			}
+		})
+	}
+}
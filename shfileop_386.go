@@ -0,0 +1,22 @@
+//go:build windows && (386 || arm)
+
+package winapi
+
+// shFileOpStruct is the SHFILEOPSTRUCTW layout on 32-bit Windows, where
+// shellapi.h packs its structs to 1-byte boundaries. The members following
+// fFlags are unaligned, so they are stored as raw bytes.
+type shFileOpStruct struct {
+	hwnd                  HWND
+	wFunc                 FO      // (UINT)
+	pFrom                 *uint16 // (PCZZWSTR)
+	pTo                   *uint16 // (PCZZWSTR)
+	fFlags                FOF     // (FILEOP_FLAGS)
+	fAnyOperationsAborted [4]byte // (BOOL)
+	hNameMappings         [4]byte // (LPVOID)
+	lpszProgressTitle     [4]byte // (PCWSTR)
+}
+
+// aborted reports whether the user aborted the operation.
+func (op *shFileOpStruct) aborted() bool {
+	return op.fAnyOperationsAborted != [4]byte{}
+}
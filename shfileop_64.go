@@ -0,0 +1,21 @@
+//go:build windows && !(386 || arm)
+
+package winapi
+
+// shFileOpStruct is the SHFILEOPSTRUCTW layout on 64-bit Windows, where its
+// members are naturally aligned.
+type shFileOpStruct struct {
+	hwnd                  HWND
+	wFunc                 FO      // (UINT)
+	pFrom                 *uint16 // (PCZZWSTR)
+	pTo                   *uint16 // (PCZZWSTR)
+	fFlags                FOF     // (FILEOP_FLAGS)
+	fAnyOperationsAborted int32   // (BOOL)
+	hNameMappings         uintptr // (LPVOID)
+	lpszProgressTitle     *uint16 // (PCWSTR)
+}
+
+// aborted reports whether the user aborted the operation.
+func (op *shFileOpStruct) aborted() bool {
+	return op.fAnyOperationsAborted != 0
+}
@@ -0,0 +1,739 @@
+package winapi
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+	"unicode/utf16"
+)
+
+// ErrShellLinkFormat is returned when data does not follow the Shell Link
+// Binary File Format (MS-SHLLINK).
+var ErrShellLinkFormat = errors.New("winapi: invalid shell link")
+
+// A ShellLink describes a shortcut (.lnk) file.
+type ShellLink struct {
+	// Target is the path of the file or folder the shortcut points to.
+	Target string
+
+	// Args are the command-line arguments passed to Target.
+	Args string
+
+	// WorkDir is the working directory Target is started in.
+	WorkDir string
+
+	// Description is the comment shown as the shortcut's tooltip.
+	Description string
+
+	// Icon is the path of the file that contains the shortcut's icon.
+	Icon string
+
+	// IconIndex is the index of the icon within Icon.
+	IconIndex int32
+
+	// Hotkey is the keyboard shortcut that starts Target. The low-order byte
+	// is a virtual key code and the high-order byte a set of [HOTKEYF] flags.
+	Hotkey uint16
+
+	// ShowCmd specifies how Target's window is shown. The zero value, which
+	// is SW_HIDE, leaves the shell default, SW_SHOWNORMAL, when creating a
+	// shortcut.
+	ShowCmd SW
+
+	// AppUserModelID is the explicit Application User Model ID used to group
+	// the shortcut's windows on the taskbar.
+	AppUserModelID string
+}
+
+// HOTKEYF represents a set of modifier flags in the high-order byte of a
+// [ShellLink] hotkey.
+type HOTKEYF uint8
+
+// [HOTKEYF] constants.
+//
+// See: https://learn.microsoft.com/en-us/openspecs/windows_protocols/ms-shllink/16cb4ca1-9339-4d0c-a68d-bf1d6cc0f943
+const (
+	HOTKEYF_SHIFT HOTKEYF = 1 << iota
+	HOTKEYF_CONTROL
+	HOTKEYF_ALT
+)
+
+// SLDF represents a set of flags that specify which shell link structures are
+// present in a shortcut file and how they are interpreted.
+type SLDF uint32
+
+// [SLDF] constants.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/shlobj_core/ne-shlobj_core-shell_link_data_flags
+const (
+	SLDF_HAS_ID_LIST SLDF = 1 << iota
+	SLDF_HAS_LINK_INFO
+	SLDF_HAS_NAME
+	SLDF_HAS_RELPATH
+	SLDF_HAS_WORKINGDIR
+	SLDF_HAS_ARGS
+	SLDF_HAS_ICONLOCATION
+	SLDF_UNICODE
+	SLDF_FORCE_NO_LINKINFO
+	SLDF_HAS_EXP_SZ
+	SLDF_RUN_IN_SEPARATE
+	_
+	SLDF_HAS_DARWINID
+	SLDF_RUNAS_USER
+	SLDF_HAS_EXP_ICON_SZ
+	SLDF_NO_PIDL_ALIAS
+	SLDF_FORCE_UNCNAME
+	SLDF_RUN_WITH_SHIMLAYER
+	SLDF_FORCE_NO_LINKTRACK
+	SLDF_ENABLE_TARGET_METADATA
+	SLDF_DISABLE_LINK_PATH_TRACKING
+	SLDF_DISABLE_KNOWNFOLDER_RELATIVE_TRACKING
+	SLDF_NO_KF_ALIAS
+	SLDF_ALLOW_LINK_TO_LINK
+	SLDF_UNALIAS_ON_SAVE
+	SLDF_PREFER_ENVIRONMENT_PATH
+	SLDF_KEEP_LOCAL_IDLIST_FOR_UNC_TARGET
+)
+
+// ExtraDataSig represents the signature of a shell link extra data block.
+type ExtraDataSig uint32
+
+// [ExtraDataSig] constants.
+//
+// See: https://learn.microsoft.com/en-us/openspecs/windows_protocols/ms-shllink/c41e062d-f764-4f13-bd4f-ea812ab9a4d1
+const (
+	EXP_SZ_LINK_SIG         ExtraDataSig = 0xA0000001
+	NT_CONSOLE_PROPS_SIG    ExtraDataSig = 0xA0000002
+	EXP_TRACKER_SIG         ExtraDataSig = 0xA0000003
+	NT_FE_CONSOLE_PROPS_SIG ExtraDataSig = 0xA0000004
+	EXP_SPECIAL_FOLDER_SIG  ExtraDataSig = 0xA0000005
+	EXP_DARWIN_ID_SIG       ExtraDataSig = 0xA0000006
+	EXP_SZ_ICON_SIG         ExtraDataSig = 0xA0000007
+	EXP_SHIM_SIG            ExtraDataSig = 0xA0000008
+	EXP_PROPERTYSTORAGE_SIG ExtraDataSig = 0xA0000009
+	EXP_KNOWN_FOLDER_SIG    ExtraDataSig = 0xA000000B
+	EXP_VISTA_ID_LIST_SIG   ExtraDataSig = 0xA000000C
+)
+
+// CLSID_ShellLink is the class identifier of shell links, which every shortcut
+// file carries in its header.
+var CLSID_ShellLink = GUID{0x00021401, 0x0000, 0x0000, [8]byte{0xC0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x46}}
+
+// pkeyAppUserModel is the format id of PKEY_AppUserModel_ID, whose property id
+// is 5, which holds a shortcut's Application User Model ID.
+var pkeyAppUserModel = GUID{0x9F4C2855, 0x9F79, 0x4B39, [8]byte{0xA8, 0xD0, 0xE1, 0xD4, 0x2D, 0xE1, 0xD5, 0xF3}}
+
+// A ShellLinkHeader is the fixed-size header at the start of every shortcut
+// file.
+//
+// See: https://learn.microsoft.com/en-us/openspecs/windows_protocols/ms-shllink/c3376b21-0931-45e4-b2fc-a48ac0e60d15
+type ShellLinkHeader struct {
+	// LinkFlags specifies which structures follow the header.
+	LinkFlags SLDF
+
+	// FileAttributes are the attributes of the link target.
+	FileAttributes uint32
+
+	// CreationTime, AccessTime and WriteTime are the timestamps of the link
+	// target. A zero FILETIME decodes to the zero time.Time.
+	CreationTime time.Time
+	AccessTime   time.Time
+	WriteTime    time.Time
+
+	// FileSize is the size of the link target, in bytes (low 32 bits).
+	FileSize uint32
+
+	// IconIndex is the index of an icon within the icon location.
+	IconIndex int32
+
+	// ShowCommand is the expected window state of the started application.
+	ShowCommand SW
+
+	// HotKey is the keyboard shortcut used to start the application.
+	HotKey uint16
+}
+
+// A LinkInfo specifies information necessary to resolve a link target if it
+// is not found in its original location.
+//
+// See: https://learn.microsoft.com/en-us/openspecs/windows_protocols/ms-shllink/6813269d-0cc8-4be2-933f-e96e8e3412dc
+type LinkInfo struct {
+	// DriveType is the type of drive the target is stored on, if the target
+	// is local.
+	DriveType uint32
+
+	// DriveSerialNumber is the serial number of the volume the target is
+	// stored on, if the target is local.
+	DriveSerialNumber uint32
+
+	// VolumeLabel is the label of the volume the target is stored on, if the
+	// target is local.
+	VolumeLabel string
+
+	// LocalBasePath is the local path prefix of the target.
+	LocalBasePath string
+
+	// NetName is the UNC share the target is stored on, e.g.
+	// `\\server\share`, if the target is on the network.
+	NetName string
+
+	// DeviceName is the drive letter the share is mapped to, e.g. "Z:".
+	DeviceName string
+
+	// CommonPathSuffix is the path appended to LocalBasePath or NetName to
+	// form the full target path.
+	CommonPathSuffix string
+}
+
+// An ExtraDataBlock is a raw extra data block from the end of a shortcut
+// file.
+type ExtraDataBlock struct {
+	// Signature identifies the kind of block.
+	Signature ExtraDataSig
+
+	// Data is the content of the block following its size and signature.
+	Data []byte
+}
+
+// A ShellLinkFile is a decoded shortcut file.
+//
+// See: https://learn.microsoft.com/en-us/openspecs/windows_protocols/ms-shllink/16cb4ca1-9339-4d0c-a68d-bf1d6cc0f943
+type ShellLinkFile struct {
+	Header ShellLinkHeader
+
+	// IDList contains the raw shell item ids of the LinkTargetIDList.
+	IDList [][]byte
+
+	// LinkInfo is nil unless the SLDF_HAS_LINK_INFO flag is set.
+	LinkInfo *LinkInfo
+
+	// Name, RelativePath, WorkingDir, Arguments and IconLocation are the
+	// StringData members present according to the header's LinkFlags.
+	Name         string
+	RelativePath string
+	WorkingDir   string
+	Arguments    string
+	IconLocation string
+
+	// ExtraData contains every extra data block, including those decoded
+	// into the fields below.
+	ExtraData []ExtraDataBlock
+
+	// EnvTarget is the target path with unexpanded environment variables,
+	// from the EXP_SZ_LINK_SIG block.
+	EnvTarget string
+
+	// EnvIconLocation is the icon path with unexpanded environment variables,
+	// from the EXP_SZ_ICON_SIG block.
+	EnvIconLocation string
+
+	// KnownFolder is the known folder the target is in, from the
+	// EXP_KNOWN_FOLDER_SIG block.
+	KnownFolder *KNOWNFOLDERID
+
+	// AppUserModelID is the Application User Model ID stored in the
+	// EXP_PROPERTYSTORAGE_SIG block.
+	AppUserModelID string
+}
+
+// Target returns the best available path of the link target: the path from
+// the LinkInfo, then the environment variable path, then the relative path.
+func (f *ShellLinkFile) Target() string {
+	if li := f.LinkInfo; li != nil {
+		switch {
+		case li.LocalBasePath != "":
+			return li.LocalBasePath + li.CommonPathSuffix
+		case li.NetName != "" && li.CommonPathSuffix != "":
+			return li.NetName + `\` + li.CommonPathSuffix
+		case li.NetName != "":
+			return li.NetName
+		}
+	}
+
+	if f.EnvTarget != "" {
+		return f.EnvTarget
+	}
+
+	return f.RelativePath
+}
+
+// ShellLink returns the [ShellLink] described by f.
+func (f *ShellLinkFile) ShellLink() ShellLink {
+	icon := f.IconLocation
+	if f.Header.LinkFlags&SLDF_HAS_EXP_ICON_SZ != 0 && f.EnvIconLocation != "" {
+		icon = f.EnvIconLocation
+	}
+
+	return ShellLink{
+		Target:         f.Target(),
+		Args:           f.Arguments,
+		WorkDir:        f.WorkingDir,
+		Description:    f.Name,
+		Icon:           icon,
+		IconIndex:      f.Header.IconIndex,
+		Hotkey:         f.Header.HotKey,
+		ShowCmd:        f.Header.ShowCommand,
+		AppUserModelID: f.AppUserModelID,
+	}
+}
+
+// ReadShortcut reads and decodes the shortcut file at path.
+// It returns an error if the file cannot be read or is not a valid shortcut.
+func ReadShortcut(path string) (ShellLink, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ShellLink{}, err
+	}
+
+	f, err := ParseShellLink(data)
+	if err != nil {
+		return ShellLink{}, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return f.ShellLink(), nil
+}
+
+// ParseShellLink decodes data in the Shell Link Binary File Format: the
+// header, LinkTargetIDList, LinkInfo, StringData and ExtraData structures.
+// It returns an error wrapping [ErrShellLinkFormat] if data is malformed.
+func ParseShellLink(data []byte) (*ShellLinkFile, error) {
+	r := &lnkReader{buf: data}
+	f := &ShellLinkFile{}
+
+	if r.u32() != 0x4C || r.guid() != CLSID_ShellLink {
+		return nil, r.fail("bad header")
+	}
+
+	h := &f.Header
+	h.LinkFlags = SLDF(r.u32())
+	h.FileAttributes = r.u32()
+	h.CreationTime = filetime(r.u64())
+	h.AccessTime = filetime(r.u64())
+	h.WriteTime = filetime(r.u64())
+	h.FileSize = r.u32()
+	h.IconIndex = int32(r.u32())
+	h.ShowCommand = SW(r.u32())
+	h.HotKey = r.u16()
+	r.skip(10) // Reserved1-3
+	if r.err != nil {
+		return nil, r.fail("truncated header")
+	}
+
+	if h.LinkFlags&SLDF_HAS_ID_LIST != 0 {
+		list := r.sub(int(r.u16()))
+		for list.err == nil && list.left() > 0 {
+			size := int(list.u16())
+			if size == 0 {
+				break
+			}
+			if size < 2 {
+				return nil, r.fail("bad item id size")
+			}
+
+			f.IDList = append(f.IDList, list.bytes(size-2))
+		}
+		if r.err != nil || list.err != nil {
+			return nil, r.fail("truncated id list")
+		}
+	}
+
+	if h.LinkFlags&SLDF_HAS_LINK_INFO != 0 {
+		start := r.off
+		size := int(r.u32())
+		r.off = start
+		li, err := parseLinkInfo(r.bytes(size))
+		if err != nil {
+			return nil, err
+		}
+
+		f.LinkInfo = li
+	}
+
+	unicode := h.LinkFlags&SLDF_UNICODE != 0
+	for _, s := range []struct {
+		flag SLDF
+		dst  *string
+	}{
+		{SLDF_HAS_NAME, &f.Name},
+		{SLDF_HAS_RELPATH, &f.RelativePath},
+		{SLDF_HAS_WORKINGDIR, &f.WorkingDir},
+		{SLDF_HAS_ARGS, &f.Arguments},
+		{SLDF_HAS_ICONLOCATION, &f.IconLocation},
+	} {
+		if h.LinkFlags&s.flag == 0 {
+			continue
+		}
+
+		n := int(r.u16())
+		if unicode {
+			*s.dst = decodeUTF16(r.bytes(2 * n))
+		} else {
+			*s.dst = decodeANSI(r.bytes(n))
+		}
+	}
+	if r.err != nil {
+		return nil, r.fail("truncated string data")
+	}
+
+	for r.left() >= 4 {
+		size := int(r.u32())
+		if size < 4 {
+			break // terminal block
+		}
+		if size < 8 {
+			return nil, r.fail("bad extra data block size")
+		}
+
+		block := ExtraDataBlock{Signature: ExtraDataSig(r.u32())}
+		block.Data = r.bytes(size - 8)
+		if r.err != nil {
+			return nil, r.fail("truncated extra data block")
+		}
+
+		f.ExtraData = append(f.ExtraData, block)
+		if err := f.decodeExtraData(block); err != nil {
+			return nil, err
+		}
+	}
+
+	return f, nil
+}
+
+// decodeExtraData fills in the fields of f that are derived from block.
+// It returns an error wrapping [ErrShellLinkFormat] if block is malformed.
+func (f *ShellLinkFile) decodeExtraData(block ExtraDataBlock) error {
+	b := &lnkReader{buf: block.Data}
+	switch block.Signature {
+	case EXP_SZ_LINK_SIG, EXP_SZ_ICON_SIG:
+		ansi := decodeANSI(b.bytes(260))
+		wide := decodeUTF16(b.bytes(520))
+		if wide == "" {
+			wide = ansi
+		}
+
+		if block.Signature == EXP_SZ_LINK_SIG {
+			f.EnvTarget = wide
+		} else {
+			f.EnvIconLocation = wide
+		}
+	case EXP_KNOWN_FOLDER_SIG:
+		if id := b.guid(); b.err == nil {
+			kf := KNOWNFOLDERID(id)
+			f.KnownFolder = &kf
+		}
+	case EXP_PROPERTYSTORAGE_SIG:
+		s, err := findStringProperty(block.Data, pkeyAppUserModel, 5)
+		if err != nil {
+			return err
+		}
+		f.AppUserModelID = s
+	}
+
+	return nil
+}
+
+// parseLinkInfo decodes a LinkInfo structure.
+func parseLinkInfo(data []byte) (*LinkInfo, error) {
+	r := &lnkReader{buf: data}
+	li := &LinkInfo{}
+
+	r.skip(4) // LinkInfoSize
+	headerSize := r.u32()
+	flags := r.u32()
+	volumeIDOff := r.u32()
+	localBaseOff := r.u32()
+	netLinkOff := r.u32()
+	suffixOff := r.u32()
+	var localBaseOffW, suffixOffW uint32
+	if headerSize >= 0x24 {
+		localBaseOffW = r.u32()
+		suffixOffW = r.u32()
+	}
+	if r.err != nil {
+		return nil, r.fail("truncated link info")
+	}
+
+	if flags&0x1 != 0 { // VolumeIDAndLocalBasePath
+		v := r.block(volumeIDOff)
+		v.skip(4) // VolumeIDSize
+		li.DriveType = v.u32()
+		li.DriveSerialNumber = v.u32()
+		labelOff := v.u32()
+		if labelOff == 0x14 {
+			li.VolumeLabel = decodeUTF16(v.at(v.u32()).cstr16())
+		} else {
+			li.VolumeLabel = decodeANSI(v.at(labelOff).cstr())
+		}
+
+		if localBaseOffW != 0 {
+			li.LocalBasePath = decodeUTF16(r.at(localBaseOffW).cstr16())
+		} else {
+			li.LocalBasePath = decodeANSI(r.at(localBaseOff).cstr())
+		}
+		if v.err != nil {
+			return nil, v.fail("bad volume id")
+		}
+	}
+
+	if flags&0x2 != 0 { // CommonNetworkRelativeLinkAndPathSuffix
+		n := r.block(netLinkOff)
+		n.skip(4) // CommonNetworkRelativeLinkSize
+		netFlags := n.u32()
+		netNameOff := n.u32()
+		deviceNameOff := n.u32()
+		n.skip(4) // NetworkProviderType
+		if netNameOff > 0x14 {
+			li.NetName = decodeUTF16(n.at(n.u32()).cstr16())
+			if off := n.u32(); netFlags&0x1 != 0 {
+				li.DeviceName = decodeUTF16(n.at(off).cstr16())
+			}
+		} else {
+			li.NetName = decodeANSI(n.at(netNameOff).cstr())
+			if netFlags&0x1 != 0 { // ValidDevice
+				li.DeviceName = decodeANSI(n.at(deviceNameOff).cstr())
+			}
+		}
+		if n.err != nil {
+			return nil, n.fail("bad network link")
+		}
+	}
+
+	if suffixOffW != 0 {
+		li.CommonPathSuffix = decodeUTF16(r.at(suffixOffW).cstr16())
+	} else if suffixOff != 0 {
+		li.CommonPathSuffix = decodeANSI(r.at(suffixOff).cstr())
+	}
+	if r.err != nil {
+		return nil, r.fail("bad link info")
+	}
+
+	return li, nil
+}
+
+// findStringProperty searches a serialized property store for the VT_LPWSTR
+// value of the property identified by fmtid and pid.
+// It returns "" if the property is not found, or an error wrapping
+// [ErrShellLinkFormat] if a storage or value size does not fit the data.
+//
+// See: https://learn.microsoft.com/en-us/openspecs/windows_protocols/ms-propstore/1eb58eb3-e7d8-4a09-ac0e-8bcb14b6fa0e
+func findStringProperty(data []byte, fmtid GUID, pid uint32) (string, error) {
+	const (
+		vtLPWSTR          = 0x1F
+		storageHeaderSize = 24 // StorageSize, Version, FormatID
+		valueHeaderSize   = 16 // ValueSize, Id, Reserved, Type, Padding
+	)
+
+	r := &lnkReader{buf: data}
+	for r.err == nil && r.left() >= 4 {
+		start := r.off
+		size := int(r.u32())
+		if size == 0 {
+			break
+		}
+		if size < storageHeaderSize || size > r.left()+4 {
+			return "", r.fail("bad property storage size")
+		}
+
+		storage := r.at(uint32(start)).sub(size)
+		r.off = start + size
+		storage.skip(8) // StorageSize, Version
+		if storage.guid() != fmtid {
+			continue
+		}
+
+		for storage.err == nil && storage.left() >= 4 {
+			vstart := storage.off
+			vsize := int(storage.u32())
+			if vsize == 0 {
+				break
+			}
+			if vsize < valueHeaderSize || vsize > storage.left()+4 {
+				return "", r.fail("bad property value size")
+			}
+
+			id := storage.u32()
+			storage.skip(1) // Reserved
+			typ := storage.u16()
+			storage.skip(2) // Padding
+			if id == pid && typ == vtLPWSTR {
+				n := int(storage.u32())
+				if s := storage.bytes(2 * n); storage.err == nil {
+					return strings.TrimRight(decodeUTF16(s), "\x00"), nil
+				}
+
+				return "", r.fail("truncated property value")
+			}
+
+			storage.off = vstart + vsize
+		}
+	}
+
+	return "", nil
+}
+
+// lnkReader reads little-endian values from a byte slice. Reads past the end
+// set err and return zero values, so a sequence of reads can be checked once.
+type lnkReader struct {
+	buf []byte
+	off int
+	err error
+}
+
+func (r *lnkReader) fail(msg string) error {
+	return fmt.Errorf("%w: %s", ErrShellLinkFormat, msg)
+}
+
+func (r *lnkReader) left() int {
+	return len(r.buf) - r.off
+}
+
+func (r *lnkReader) bytes(n int) []byte {
+	if r.err != nil || n < 0 || r.off < 0 || n > r.left() {
+		r.err = ErrShellLinkFormat
+		return nil
+	}
+
+	b := r.buf[r.off : r.off+n]
+	r.off += n
+
+	return b
+}
+
+func (r *lnkReader) skip(n int) {
+	r.bytes(n)
+}
+
+func (r *lnkReader) u16() uint16 {
+	if b := r.bytes(2); b != nil {
+		return binary.LittleEndian.Uint16(b)
+	}
+
+	return 0
+}
+
+func (r *lnkReader) u32() uint32 {
+	if b := r.bytes(4); b != nil {
+		return binary.LittleEndian.Uint32(b)
+	}
+
+	return 0
+}
+
+func (r *lnkReader) u64() uint64 {
+	if b := r.bytes(8); b != nil {
+		return binary.LittleEndian.Uint64(b)
+	}
+
+	return 0
+}
+
+func (r *lnkReader) guid() (g GUID) {
+	g.Data1 = r.u32()
+	g.Data2 = r.u16()
+	g.Data3 = r.u16()
+	copy(g.Data4[:], r.bytes(8))
+
+	return g
+}
+
+// sub returns a reader over the next n bytes and advances past them.
+func (r *lnkReader) sub(n int) *lnkReader {
+	b := r.bytes(n)
+	return &lnkReader{buf: b, err: r.err}
+}
+
+// at returns a reader positioned at off within the whole buffer.
+func (r *lnkReader) at(off uint32) *lnkReader {
+	s := &lnkReader{buf: r.buf, off: int(off)}
+	if uint64(off) > uint64(len(r.buf)) {
+		s.err = ErrShellLinkFormat
+	}
+
+	return s
+}
+
+// block returns a reader over the structure at off within the whole buffer,
+// whose size is given by its first 32-bit member. Offsets within the structure
+// are relative to its start.
+func (r *lnkReader) block(off uint32) *lnkReader {
+	s := r.at(off)
+	size := s.u32()
+	s.off -= 4
+
+	return s.sub(int(size))
+}
+
+// cstr returns the bytes up to the next NUL.
+func (r *lnkReader) cstr() []byte {
+	for i := r.off; r.err == nil && i >= 0 && i < len(r.buf); i++ {
+		if r.buf[i] == 0 {
+			b := r.bytes(i - r.off + 1)
+			return b[:len(b)-1]
+		}
+	}
+
+	r.err = ErrShellLinkFormat
+
+	return nil
+}
+
+// cstr16 returns the UTF-16 bytes up to the next NUL character.
+func (r *lnkReader) cstr16() []byte {
+	for i := r.off; r.err == nil && i >= 0 && i+1 < len(r.buf); i += 2 {
+		if r.buf[i] == 0 && r.buf[i+1] == 0 {
+			b := r.bytes(i - r.off + 2)
+			return b[:len(b)-2]
+		}
+	}
+
+	r.err = ErrShellLinkFormat
+
+	return nil
+}
+
+// decodeUTF16 decodes little-endian UTF-16 bytes, stopping at the first NUL.
+func decodeUTF16(b []byte) string {
+	u := make([]uint16, 0, len(b)/2)
+	for i := 0; i+1 < len(b); i += 2 {
+		c := binary.LittleEndian.Uint16(b[i:])
+		if c == 0 {
+			break
+		}
+
+		u = append(u, c)
+	}
+
+	return string(utf16.Decode(u))
+}
+
+// decodeANSI decodes bytes in the system code page, stopping at the first NUL.
+// The code page is not recorded in the file, so bytes are decoded as
+// ISO-8859-1, which is exact for ASCII.
+func decodeANSI(b []byte) string {
+	var sb strings.Builder
+	for _, c := range b {
+		if c == 0 {
+			break
+		}
+
+		sb.WriteRune(rune(c))
+	}
+
+	return sb.String()
+}
+
+// filetime converts a FILETIME, in 100 ns intervals since January 1, 1601 UTC,
+// to a time.Time.
+func filetime(ft uint64) time.Time {
+	if ft == 0 {
+		return time.Time{}
+	}
+
+	const epochDelta = 116444736000000000 // 1601-01-01 to 1970-01-01
+	ns := (int64(ft) - epochDelta) * 100
+
+	return time.Unix(0, ns).UTC()
+}
@@ -0,0 +1,169 @@
+package winapi_test
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kamaranl/gotools/test"
+	"github.com/kamaranl/winapi"
+)
+
+func TestReadShortcut(t *testing.T) {
+	tName := "ReadShortcut"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	scenes := []test.Scene{
+		{
+			Input: "testdata/local.lnk",
+			Output: winapi.ShellLink{
+				Target:         `C:\Windows\System32\notepad.exe`,
+				Args:           `/A "ünïcode.txt"`,
+				WorkDir:        `C:\Users\Public`,
+				Description:    "Text Editor",
+				Icon:           `%SystemRoot%\System32\shell32.dll`,
+				IconIndex:      2,
+				Hotkey:         uint16(winapi.HOTKEYF_CONTROL|winapi.HOTKEYF_ALT)<<8 | 'T',
+				ShowCmd:        winapi.SW_SHOWMAXIMIZED,
+				AppUserModelID: "Contoso.TextEditor",
+			},
+		},
+		{
+			Input: "testdata/network.lnk",
+			Output: winapi.ShellLink{
+				Target:  `\\FILESRV\Shared\Reports\Q3.xlsx`,
+				Args:    "-r",
+				ShowCmd: winapi.SW_SHOWNORMAL,
+			},
+		},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			got, err := winapi.ReadShortcut(s.Input.(string))
+			want := s.Output.(winapi.ShellLink)
+
+			if err != nil {
+				t.Fatalf(test.ErrUnexpectedF, err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf(test.ErrWantFGotF, want, got)
+			}
+		})
+	}
+}
+
+func TestParseShellLink(t *testing.T) {
+	tName := "ParseShellLink"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	data, err := os.ReadFile("testdata/local.lnk")
+	if err != nil {
+		t.Fatalf(test.ErrUnexpectedF, err)
+	}
+
+	f, err := winapi.ParseShellLink(data)
+	if err != nil {
+		t.Fatalf(test.ErrUnexpectedF, err)
+	}
+
+	if want := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC); !f.Header.WriteTime.Equal(want) {
+		t.Errorf(test.ErrWantFGotF, want, f.Header.WriteTime)
+	}
+	if got := len(f.IDList); got != 2 {
+		t.Errorf(test.ErrWantFGotF, 2, got)
+	}
+	if li := f.LinkInfo; li == nil || li.VolumeLabel != "OS" || li.DriveSerialNumber != 0x1234ABCD {
+		t.Errorf(test.ErrWantFGotF, "volume OS/1234ABCD", li)
+	}
+	if want := `%windir%\System32\notepad.exe`; f.EnvTarget != want {
+		t.Errorf(test.ErrWantFGotF, want, f.EnvTarget)
+	}
+	if f.KnownFolder == nil || *f.KnownFolder != *winapi.FOLDERID_System {
+		t.Errorf(test.ErrWantFGotF, winapi.FOLDERID_System, f.KnownFolder)
+	}
+	if got := len(f.ExtraData); got != 4 {
+		t.Errorf(test.ErrWantFGotF, 4, got)
+	}
+
+	net, err := os.ReadFile("testdata/network.lnk")
+	if err != nil {
+		t.Fatalf(test.ErrUnexpectedF, err)
+	}
+
+	f, err = winapi.ParseShellLink(net)
+	if err != nil {
+		t.Fatalf(test.ErrUnexpectedF, err)
+	}
+	if li := f.LinkInfo; li == nil || li.DeviceName != "Z:" {
+		t.Errorf(test.ErrWantFGotF, "Z:", li)
+	}
+	if want := `..\..\Reports\Q3.xlsx`; f.RelativePath != want {
+		t.Errorf(test.ErrWantFGotF, want, f.RelativePath)
+	}
+
+	// Malformed files must fail cleanly: an offset past the end of the file,
+	// including those that do not fit an int on 32-bit builds, and property
+	// values whose sizes point back at each other.
+	header := append([]byte(nil), data[:0x4C]...)
+	binary.LittleEndian.PutUint32(header[0x14:], uint32(winapi.SLDF_HAS_LINK_INFO))
+	badOffset := append([]byte(nil), header...)
+	badOffset = binary.LittleEndian.AppendUint32(badOffset, 0x1C) // LinkInfoSize
+	badOffset = binary.LittleEndian.AppendUint32(badOffset, 0x1C) // LinkInfoHeaderSize
+	badOffset = binary.LittleEndian.AppendUint32(badOffset, 0x01) // VolumeIDAndLocalBasePath
+	badOffset = binary.LittleEndian.AppendUint32(badOffset, 0xFFFFFFFF)
+	badOffset = append(badOffset, make([]byte, 12)...)
+
+	storage := binary.LittleEndian.AppendUint32(nil, 24+16+16) // StorageSize
+	storage = binary.LittleEndian.AppendUint32(storage, 0x53505331)
+	storage = append(storage, 0x55, 0x28, 0x4C, 0x9F, 0x79, 0x9F, 0x39, 0x4B, 0xA8, 0xD0, 0xE1, 0xD4, 0x2D, 0xE1, 0xD5, 0xF3)
+	storage = binary.LittleEndian.AppendUint32(storage, 16) // forward to the next value
+	storage = append(storage, make([]byte, 12)...)
+	storage = binary.LittleEndian.AppendUint32(storage, 0xFFFFFFF0) // back to the previous value
+	storage = append(storage, make([]byte, 12)...)
+	backPointer := append([]byte(nil), header...)
+	binary.LittleEndian.PutUint32(backPointer[0x14:], 0)
+	backPointer = binary.LittleEndian.AppendUint32(backPointer, uint32(8+len(storage)))
+	backPointer = binary.LittleEndian.AppendUint32(backPointer, uint32(winapi.EXP_PROPERTYSTORAGE_SIG))
+	backPointer = append(backPointer, storage...)
+
+	scenes := []test.Scene{
+		{Input: badOffset, Output: winapi.ErrShellLinkFormat},
+		{Input: backPointer, Output: winapi.ErrShellLinkFormat},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			if _, err := winapi.ParseShellLink(s.Input.([]byte)); !errors.Is(err, s.Output.(error)) {
+				t.Errorf(test.ErrWantFGotF, s.Output, err)
+			}
+		})
+	}
+
+	// Every truncation of a valid file must fail cleanly rather than panic.
+	// A missing ExtraData terminal block is tolerated, so truncating exactly
+	// at a block boundary still succeeds.
+	f, _ = winapi.ParseShellLink(data)
+	stringsEnd := len(data) - 4
+	for _, b := range f.ExtraData {
+		stringsEnd -= 8 + len(b.Data)
+	}
+
+	for n := 0; n < len(data); n++ {
+		_, err := winapi.ParseShellLink(data[:n])
+		if err == nil && n < stringsEnd {
+			t.Errorf("truncated to %d bytes: wanted error, got nil", n)
+		}
+		if err != nil && !errors.Is(err, winapi.ErrShellLinkFormat) {
+			t.Errorf(test.ErrWantFGotF, winapi.ErrShellLinkFormat, err)
+		}
+	}
+}
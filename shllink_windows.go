@@ -0,0 +1,157 @@
+//go:build windows
+
+package winapi
+
+import (
+	"runtime"
+	"syscall"
+	"unsafe"
+)
+
+var (
+	iidIShellLinkW    = GUID{0x000214F9, 0x0000, 0x0000, [8]byte{0xC0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x46}}
+	iidIPersistFile   = GUID{0x0000010B, 0x0000, 0x0000, [8]byte{0xC0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x46}}
+	iidIPropertyStore = GUID{0x886D8EEB, 0x8CF2, 0x4446, [8]byte{0x8D, 0x02, 0xCD, 0xBA, 0x1D, 0xBD, 0xCF, 0x99}}
+)
+
+// IShellLinkW, IPersistFile and IPropertyStore vtable indices.
+const (
+	shellLinkSetDescription      = 7
+	shellLinkSetWorkingDirectory = 9
+	shellLinkSetArguments        = 11
+	shellLinkSetHotkey           = 13
+	shellLinkSetShowCmd          = 15
+	shellLinkSetIconLocation     = 17
+	shellLinkSetPath             = 20
+	persistFileSave              = 6
+	propertyStoreSetValue        = 6
+	propertyStoreCommit          = 7
+)
+
+// propertyKey is a PROPERTYKEY.
+type propertyKey struct {
+	fmtid GUID
+	pid   uint32
+}
+
+// propVariant is a PROPVARIANT holding a pointer-sized value.
+type propVariant struct {
+	vt  uint16
+	_   [3]uint16
+	val uintptr
+	_   uintptr
+}
+
+// CreateShortcut creates, or overwrites, the shortcut file at path as
+// described by link, through the IShellLinkW and IPersistFile interfaces.
+// The path should end in ".lnk".
+// It returns an error if the shortcut cannot be created.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/shell/links
+//
+// Experimental: CreateShortcut has not been tested or used internally.
+func CreateShortcut(path string, link ShellLink) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	uninit, err := coInitialize()
+	if err != nil {
+		return err
+	}
+	defer uninit()
+
+	sl, err := coCreateInstance(&CLSID_ShellLink, &iidIShellLinkW)
+	if err != nil {
+		return err
+	}
+	defer sl.release()
+
+	for _, s := range []struct {
+		method int
+		val    string
+	}{
+		{shellLinkSetPath, link.Target},
+		{shellLinkSetArguments, link.Args},
+		{shellLinkSetWorkingDirectory, link.WorkDir},
+		{shellLinkSetDescription, link.Description},
+	} {
+		if s.val == "" {
+			continue
+		}
+
+		p, err := syscall.UTF16PtrFromString(s.val)
+		if err != nil {
+			return err
+		}
+		if err := hresult(sl.call(s.method, uintptr(unsafe.Pointer(p)))); err != nil {
+			return err
+		}
+	}
+
+	if link.Icon != "" {
+		p, err := syscall.UTF16PtrFromString(link.Icon)
+		if err != nil {
+			return err
+		}
+		if err := hresult(sl.call(shellLinkSetIconLocation, uintptr(unsafe.Pointer(p)), uintptr(link.IconIndex))); err != nil {
+			return err
+		}
+	}
+
+	if link.Hotkey != 0 {
+		if err := hresult(sl.call(shellLinkSetHotkey, uintptr(link.Hotkey))); err != nil {
+			return err
+		}
+	}
+	if link.ShowCmd != 0 {
+		if err := hresult(sl.call(shellLinkSetShowCmd, uintptr(link.ShowCmd))); err != nil {
+			return err
+		}
+	}
+
+	if link.AppUserModelID != "" {
+		if err := setAppUserModelID(sl, link.AppUserModelID); err != nil {
+			return err
+		}
+	}
+
+	pf, err := sl.queryInterface(&iidIPersistFile)
+	if err != nil {
+		return err
+	}
+	defer pf.release()
+
+	p, err := syscall.UTF16PtrFromString(path)
+	if err != nil {
+		return err
+	}
+
+	return hresult(pf.call(persistFileSave, uintptr(unsafe.Pointer(p)), 1))
+}
+
+// setAppUserModelID stores id as the PKEY_AppUserModel_ID property of the
+// shell link sl.
+func setAppUserModelID(sl *comObject, id string) error {
+	const vtLPWSTR = 0x1F
+
+	ps, err := sl.queryInterface(&iidIPropertyStore)
+	if err != nil {
+		return err
+	}
+	defer ps.release()
+
+	p, err := syscall.UTF16PtrFromString(id)
+	if err != nil {
+		return err
+	}
+
+	key := propertyKey{fmtid: pkeyAppUserModel, pid: 5}
+	pv := propVariant{vt: vtLPWSTR, val: uintptr(unsafe.Pointer(p))}
+	r1 := ps.call(propertyStoreSetValue, uintptr(unsafe.Pointer(&key)), uintptr(unsafe.Pointer(&pv)))
+	runtime.KeepAlive(p)
+	if err := hresult(r1); err != nil {
+		return err
+	}
+
+	return hresult(ps.call(propertyStoreCommit))
+}
@@ -2,7 +2,11 @@
 
 package winapi
 
-import "golang.org/x/sys/windows"
+import (
+	"syscall"
+
+	"golang.org/x/sys/windows"
+)
 
 // #region type-aliases
 
@@ -13,156 +17,227 @@ type HWND = windows.HWND
 // #endregion
 // #region types
 
-// A POINT is a struct that defines x- and y- coordinates of a point.
+// A SHELLEXECUTEINFOW is a struct that contains information used by
+// ShellExecuteExW.
 //
-// See: https://learn.microsoft.com/en-us/windows/win32/api/windef/ns-windef-point
-type POINT struct {
-	// X is the x-coordinate of the point.
-	X int32 // (LONG)
+// See: https://learn.microsoft.com/en-us/windows/win32/api/shellapi/ns-shellapi-shellexecuteinfow
+type SHELLEXECUTEINFOW struct {
+	// CbSize is the size of the struct, in bytes.
+	CbSize uint32 // (DWORD)
 
-	// Y is the y-coordinate of the point.
-	Y int32 // (LONG)
-}
+	// Mask specifies the content and validity of the other members.
+	Mask SEEMask // (ULONG)
 
-// A MSG is a struct that contains message information from a thread's message
-// queue.
-//
-// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-msg
-type MSG struct {
-	// Hwnd is a handle to the window whose procedure receives the message.
+	// Hwnd is a handle to the parent window used for any UI.
 	Hwnd HWND
 
-	// Message is the message identifier.
-	Message uint32 // (UINT)
+	// Verb is the action to perform, e.g. "open" or "runas".
+	Verb *uint16 // (LPCWSTR)
 
-	// WParam is additional information about the message.
-	WParam uintptr
+	// File is the file or object on which to execute Verb.
+	File *uint16 // (LPCWSTR)
 
-	// Lparam is additional information about the message.
-	LParam uintptr
+	// Parameters are the parameters passed to the application.
+	Parameters *uint16 // (LPCWSTR)
 
-	// Time is the time at which the message was posted.
-	Time uint32 // (DWORD)
+	// Directory is the working directory.
+	Directory *uint16 // (LPCWSTR)
 
-	// Pt is the cursor position when the message was posted.
-	Pt POINT
+	// Show specifies how the application is to be shown.
+	Show SW // (int)
 
-	// LPrivate
-	LPrivate uint32 // (DWORD)
-}
+	// InstApp is set to a value greater than 32 on success, or to an [SEErr]
+	// on failure.
+	InstApp uintptr // (HINSTANCE)
 
-// An INPUT_Mi is a struct used by SendInput for synthesizing mouse events.
-//
-// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-input#members
-type INPUT_Mi struct {
-	// Type is the type of input event, INPUT_MOUSE in this case.
-	Type IEvent // (DWORD)
+	// IDList is a pointer to an ITEMIDLIST identifying the file to execute.
+	IDList uintptr // (void*)
+
+	// Class is a ProgId, URI protocol scheme, or file extension.
+	Class *uint16 // (LPCWSTR)
+
+	// KeyClass is a handle to the registry key for the file type.
+	KeyClass Handle // (HKEY)
 
-	// Mi is the [MOUSEINPUT] struct.
-	Mi MOUSEINPUT
+	// HotKey is the keyboard shortcut to associate with the application.
+	HotKey uint32 // (DWORD)
+
+	// IconOrMonitor is a handle to an icon or a monitor, depending on Mask.
+	IconOrMonitor Handle // (HANDLE)
+
+	// Process is a handle to the newly started process, if
+	// SEE_MASK_NOCLOSEPROCESS is set.
+	Process Handle // (HANDLE)
 }
 
-// An INPUT_Ki is a struct used by SendInput for synthesizing keyboard events.
+// A SHFILEINFOW is a struct that contains information about a file object,
+// retrieved by SHGetFileInfoW.
 //
-// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-input#members
-type INPUT_Ki struct {
-	// Type is the type of input event, INPUT_KEYBOARD in this case.
-	Type IEvent // (DWORD)
+// See: https://learn.microsoft.com/en-us/windows/win32/api/shellapi/ns-shellapi-shfileinfow
+type SHFILEINFOW struct {
+	// Icon is a handle to the icon that represents the file.
+	Icon Handle // (HICON)
+
+	// IconIndex is the index of the icon image within the system image list.
+	IconIndex int32 // (int)
 
-	// Ki is the [KEYBDINPUT] struct.
-	Ki KEYBDINPUT
+	// Attributes are the attributes of the file object.
+	Attributes uint32 // (DWORD)
 
-	_ [8]byte // padding
+	// DisplayName is the name of the file as it appears in the shell.
+	DisplayName [260]uint16 // (WCHAR[MAX_PATH])
+
+	// TypeName describes the type of file.
+	TypeName [80]uint16 // (WCHAR[80])
 }
 
-// An INPUT_Hi is a struct used by SendInput for synthesizing hardware events,
-// other than those from a keyboard or mouse.
+// An ICONINFO is a struct that contains information about an icon or cursor.
 //
-// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-input#members
-type INPUT_Hi struct {
-	// Type is the type of input event, INPUT_HARDWARE in this case.
-	Type IEvent // (DWORD)
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-iconinfo
+type ICONINFO struct {
+	// Icon is nonzero for icons and zero for cursors.
+	Icon int32 // (BOOL)
+
+	// XHotspot is the x-coordinate of a cursor's hot spot.
+	XHotspot uint32 // (DWORD)
+
+	// YHotspot is the y-coordinate of a cursor's hot spot.
+	YHotspot uint32 // (DWORD)
 
-	// Hi is the [HARDWAREINPUT] struct.
-	Hi HARDWAREINPUT
+	// Mask is the icon's AND mask bitmap. For monochrome icons, it holds the
+	// AND mask in its upper half and the XOR mask in its lower half.
+	Mask Handle // (HBITMAP)
 
-	_ [28]byte
+	// Color is the icon's color bitmap, or 0 for monochrome icons.
+	Color Handle // (HBITMAP)
+}
+
+// A BITMAP is a struct that defines the type, size and color format of a
+// bitmap.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/wingdi/ns-wingdi-bitmap
+type BITMAP struct {
+	Type       int32   // (LONG)
+	Width      int32   // (LONG)
+	Height     int32   // (LONG)
+	WidthBytes int32   // (LONG)
+	Planes     uint16  // (WORD)
+	BitsPixel  uint16  // (WORD)
+	Bits       uintptr // (LPVOID)
 }
 
-// A MOUSEINPUT is a struct that contains information about a simulated mouse
-// event.
+// A BITMAPINFOHEADER is a struct that contains information about the
+// dimensions and color format of a device-independent bitmap.
 //
-// See: https://learn.microsoft.com/en-us/windows/desktop/api/winuser/ns-winuser-mouseinput
-type MOUSEINPUT struct {
-	// X is the absolute x coordinate of the mouse.
-	X int32 // (LONG)
+// See: https://learn.microsoft.com/en-us/windows/win32/api/wingdi/ns-wingdi-bitmapinfoheader
+type BITMAPINFOHEADER struct {
+	Size          uint32 // (DWORD)
+	Width         int32  // (LONG)
+	Height        int32  // (LONG)
+	Planes        uint16 // (WORD)
+	BitCount      uint16 // (WORD)
+	Compression   uint32 // (DWORD)
+	SizeImage     uint32 // (DWORD)
+	XPelsPerMeter int32  // (LONG)
+	YPelsPerMeter int32  // (LONG)
+	ClrUsed       uint32 // (DWORD)
+	ClrImportant  uint32 // (DWORD)
+}
 
-	// Y is the absolute y coordinate of the mouse.
-	Y int32 // (LONG)
+// A KBDLLHOOKSTRUCT is a struct that contains information about a low-level
+// keyboard input event.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-kbdllhookstruct
+type KBDLLHOOKSTRUCT struct {
+	// VkCode is a virtual key code within the range of 1-254.
+	VkCode uint32 // (DWORD)
 
-	// MouseData specifies the amount of wheel movement if Flags contains
-	// MOUSEEVENTF_WHEEL.
-	MouseData MiData // (DWORD)
+	// ScanCode is the hardware scan code for the key.
+	ScanCode uint32 // (DWORD)
 
-	// Flags specifies various aspects of mouse motion and button clicks.
-	Flags MiFlags // (DWORD)
+	// Flags specifies the extended-key flag, event-injected flags, context
+	// code, and transition-state flag.
+	Flags LLKHF // (DWORD)
 
 	// Time is the timestamp for the event, in ms.
 	Time uint32 // (DWORD)
 
-	// ExtraInfo is an additional value associated with the mouse event.
-	ExtraInfo uintptr // (ULONG__PTR)
+	// ExtraInfo is an additional value associated with the keystroke.
+	ExtraInfo uintptr // (ULONG_PTR)
 }
 
-// A KEYBDINPUT is a struct that contains informationa about a simulated
-// keyboard event.
+// A MSLLHOOKSTRUCT is a struct that contains information about a low-level
+// mouse input event.
 //
-// See: https://learn.microsoft.com/en-us/windows/desktop/api/winuser/ns-winuser-keybdinput
-type KEYBDINPUT struct {
-	// Vk is a virtual key code within the range of 1-254.
-	Vk uint16 // (WORD)
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-msllhookstruct
+type MSLLHOOKSTRUCT struct {
+	// Pt is the cursor position, in per-monitor aware screen coordinates.
+	Pt POINT
 
-	// Scan is a hardware scan code for a key.
-	Scan uint16 // (WORD)
+	// MouseData specifies the wheel delta for wheel messages, or the X button
+	// for X button messages, in its high-order word.
+	MouseData uint32 // (DWORD)
 
-	// Flags specifies various aspects of a keystroke.
-	Flags KiFlags // (DWORD)
+	// Flags specifies the event-injected flags.
+	Flags LLMHF // (DWORD)
 
 	// Time is the timestamp for the event, in ms.
 	Time uint32 // (DWORD)
 
-	// ExtraInfo is an additional value associated with the keystroke.
-	ExtraInfo uintptr // (ULONG__PTR)
+	// ExtraInfo is an additional value associated with the mouse event.
+	ExtraInfo uintptr // (ULONG_PTR)
 }
 
-// A HARDWAREINPUT is a struct that contains information about a simulated
-// message generated by an input device other than a keyboard or mouse.
-//
-// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-hardwareinput
+// A RAWINPUTDEVICE is a struct that defines information for a raw input
+// device, as registered by RegisterRawInputDevices.
 //
-// Experimental: HARDWAREINPUT has not been tested or used internally.
-type HARDWAREINPUT struct {
-	// Msg is the message generated by the input hardware.
-	Msg uint32 // (DWORD)
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-rawinputdevice
+type RAWINPUTDEVICE struct {
+	// UsagePage is the top level collection usage page, e.g.
+	// HID_USAGE_PAGE_GENERIC.
+	UsagePage uint16 // (USHORT)
+
+	// Usage is the top level collection usage, e.g. HID_USAGE_GENERIC_KEYBRD.
+	Usage uint16 // (USHORT)
 
-	// ParamL is the low-order word of the lParam for Msg.
-	ParamL uint16 // (WORD)
+	// Flags specifies how to interpret UsagePage and Usage.
+	Flags RIDEV // (DWORD)
 
-	// ParamH is the high-order word of the lParam for Msg.
-	ParamH uint16 // (WORD)
+	// Target is a handle to the window that receives the input, or 0 to
+	// follow the keyboard focus.
+	Target HWND
 }
 
-// IEvent represents the type of input event (mouse, keyboard, hardware).
-type IEvent uint32
+// A RAWINPUTDEVICELIST is a struct that contains information about a raw input
+// device.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-rawinputdevicelist
+type RAWINPUTDEVICELIST struct {
+	// Device is a handle to the raw input device.
+	Device Handle
+
+	// Type is the type of device.
+	Type RIM // (DWORD)
+}
 
-// [IEvent] constants.
+// A WNDCLASSEXW is a struct that contains window class information, as
+// registered by RegisterClassExW.
 //
-// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-input#members
-const (
-	INPUT_MOUSE IEvent = iota
-	INPUT_KEYBOARD
-	INPUT_HARDWARE
-)
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-wndclassexw
+type WNDCLASSEXW struct {
+	Size       uint32 // (UINT)
+	Style      uint32 // (UINT)
+	WndProc    uintptr
+	ClsExtra   int32
+	WndExtra   int32
+	Instance   Handle
+	Icon       Handle
+	Cursor     Handle
+	Background Handle
+	MenuName   *uint16
+	ClassName  *uint16
+	IconSm     Handle
+}
 
 // MapVKType represents a set of available maps for MapVirtualKey.
 type MapVKType uint32
@@ -178,57 +253,6 @@ const (
 	MAPVK_VK_TO_VSC_EX
 )
 
-// MiData represents a set of MOUSEINPUT event data.
-//
-// Experimental: MiData has not been tested or used internally.
-type MiData uint32
-
-// [MiData] constants.
-//
-// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-mouseinput#members
-const (
-	XBUTTON1 MiData = 1 << iota
-	XBUTTON2
-)
-
-// MiFlags represents a set of MOUSEINPUT event flags.
-type MiFlags uint32
-
-// [MiFlags] constants.
-//
-// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-mouseinput#members
-const (
-	MOUSEEVENTF_MOVE MiFlags = 1 << iota
-	MOUSEEVENTF_LEFTDOWN
-	MOUSEEVENTF_LEFTUP
-	MOUSEEVENTF_RIGHTDOWN
-	MOUSEEVENTF_RIGHTUP
-	MOUSEEVENTF_MIDDLEDOWN
-	MOUSEEVENTF_MIDDLEUP
-	MOUSEEVENTF_XDOWN
-	MOUSEEVENTF_XUP
-	_
-	_
-	MOUSEEVENTF_WHEEL
-	MOUSEEVENTF_HWHEEL
-	MOUSEEVENTF_MOVE_NOCOALESCE
-	MOUSEEVENTF_VIRTUALDESK
-	MOUSEEVENTF_ABSOLUTE
-)
-
-// KiFlags represents a set of KEYBDINPUT event flags.
-type KiFlags uint32
-
-// [KiFlags] constants.
-//
-// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-keybdinput#members
-const (
-	KEYEVENTF_EXTENDEDKEY KiFlags = 1 << iota
-	KEYEVENTF_KEYUP
-	KEYEVENTF_UNICODE
-	KEYEVENTF_SCANCODE
-)
-
 // WS represents a set of window styles.
 //
 // Experimental: WS has not been tested or used internally.
@@ -293,10 +317,32 @@ type MsgId uint32
 //
 // See: https://learn.microsoft.com/en-us/windows/win32/winmsg/about-messages-and-message-queues#system-defined-messages
 const (
-	WM_QUIT    MsgId = 0x0012
-	WM_KEYDOWN MsgId = 0x0100
-	WM_KEYUP   MsgId = 0x0101
-	WM_COMMAND MsgId = 0x0111
+	WM_DESTROY                MsgId = 0x0002
+	WM_CLOSE                  MsgId = 0x0010
+	WM_QUIT                   MsgId = 0x0012
+	WM_DISPLAYCHANGE          MsgId = 0x007E
+	WM_INPUTLANGCHANGEREQUEST MsgId = 0x0050
+	WM_INPUTLANGCHANGE        MsgId = 0x0051
+	WM_INPUT_DEVICE_CHANGE    MsgId = 0x00FE
+	WM_INPUT                  MsgId = 0x00FF
+	WM_KEYDOWN                MsgId = 0x0100
+	WM_KEYUP                  MsgId = 0x0101
+	WM_CHAR                   MsgId = 0x0102
+	WM_SYSKEYDOWN             MsgId = 0x0104
+	WM_SYSKEYUP               MsgId = 0x0105
+	WM_COMMAND                MsgId = 0x0111
+	WM_MOUSEMOVE              MsgId = 0x0200
+	WM_LBUTTONDOWN            MsgId = 0x0201
+	WM_LBUTTONUP              MsgId = 0x0202
+	WM_RBUTTONDOWN            MsgId = 0x0204
+	WM_RBUTTONUP              MsgId = 0x0205
+	WM_MBUTTONDOWN            MsgId = 0x0207
+	WM_MBUTTONUP              MsgId = 0x0208
+	WM_MOUSEWHEEL             MsgId = 0x020A
+	WM_XBUTTONDOWN            MsgId = 0x020B
+	WM_XBUTTONUP              MsgId = 0x020C
+	WM_MOUSEHWHEEL            MsgId = 0x020E
+	WM_USER                   MsgId = 0x0400
 )
 
 // ACPId represents the id of the process whose console is to be used.
@@ -441,4 +487,490 @@ const (
 	WINEVENT_INCONTEXT
 )
 
+// KFFlag represents a set of flags that specify special retrieval options for
+// known folders.
+//
+// By default, the path of a redirected folder (e.g. a roaming AppData moved to
+// a network share) is returned. KF_FLAG_DEFAULT_PATH returns the default,
+// non-redirected location instead, and KF_FLAG_DONT_VERIFY skips the check
+// that a redirected folder is reachable.
+type KFFlag uint32
+
+// [KFFlag] constants.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/shlobj_core/ne-shlobj_core-known_folder_flag
+const (
+	KF_FLAG_DEFAULT                          KFFlag = 0x00000000
+	KF_FLAG_FORCE_APP_DATA_REDIRECTION       KFFlag = 0x00080000
+	KF_FLAG_RETURN_FILTER_REDIRECTION_TARGET KFFlag = 0x00040000
+	KF_FLAG_FORCE_PACKAGE_REDIRECTION        KFFlag = 0x00020000
+	KF_FLAG_NO_PACKAGE_REDIRECTION           KFFlag = 0x00010000
+	KF_FLAG_FORCE_APPCONTAINER_REDIRECTION   KFFlag = 0x00020000
+	KF_FLAG_NO_APPCONTAINER_REDIRECTION      KFFlag = 0x00010000
+	KF_FLAG_CREATE                           KFFlag = 0x00008000
+	KF_FLAG_DONT_VERIFY                      KFFlag = 0x00004000
+	KF_FLAG_DONT_UNEXPAND                    KFFlag = 0x00002000
+	KF_FLAG_NO_ALIAS                         KFFlag = 0x00001000
+	KF_FLAG_INIT                             KFFlag = 0x00000800
+	KF_FLAG_DEFAULT_PATH                     KFFlag = 0x00000400
+	KF_FLAG_NOT_PARENT_RELATIVE              KFFlag = 0x00000200
+	KF_FLAG_SIMPLE_IDLIST                    KFFlag = 0x00000100
+	KF_FLAG_ALIAS_ONLY                       KFFlag = 0x80000000
+)
+
+// SEEMask represents a set of flags that indicate the content and validity of
+// the other members of a [SHELLEXECUTEINFOW].
+type SEEMask uint32
+
+// [SEEMask] constants (partial).
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/shellapi/ns-shellapi-shellexecuteinfow#members
+const (
+	SEE_MASK_DEFAULT        SEEMask = 0x00000000
+	SEE_MASK_CLASSNAME      SEEMask = 0x00000001
+	SEE_MASK_CLASSKEY       SEEMask = 0x00000003
+	SEE_MASK_IDLIST         SEEMask = 0x00000004
+	SEE_MASK_NOCLOSEPROCESS SEEMask = 0x00000040
+	SEE_MASK_NOASYNC        SEEMask = 0x00000100
+	SEE_MASK_DOENVSUBST     SEEMask = 0x00000200
+	SEE_MASK_FLAG_NO_UI     SEEMask = 0x00000400
+	SEE_MASK_UNICODE        SEEMask = 0x00004000
+	SEE_MASK_NO_CONSOLE     SEEMask = 0x00008000
+	SEE_MASK_NOZONECHECKS   SEEMask = 0x00800000
+	SEE_MASK_HMONITOR       SEEMask = 0x00200000
+)
+
+// SEErr represents the HINSTANCE-style error codes reported by the
+// ShellExecute family of functions.
+type SEErr uintptr
+
+// [SEErr] constants. An InstApp of 0 also means that the system is out of
+// memory or resources.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/shellapi/nf-shellapi-shellexecutew#return-value
+const (
+	SE_ERR_FNF             SEErr = 2
+	SE_ERR_PNF             SEErr = 3
+	SE_ERR_ACCESSDENIED    SEErr = 5
+	SE_ERR_OOM             SEErr = 8
+	SE_ERR_BAD_FORMAT      SEErr = 11
+	SE_ERR_SHARE           SEErr = 26
+	SE_ERR_ASSOCINCOMPLETE SEErr = 27
+	SE_ERR_DDETIMEOUT      SEErr = 28
+	SE_ERR_DDEFAIL         SEErr = 29
+	SE_ERR_DDEBUSY         SEErr = 30
+	SE_ERR_NOASSOC         SEErr = 31
+	SE_ERR_DLLNOTFOUND     SEErr = 32
+)
+
+// FO represents a file operation performed by SHFileOperationW.
+type FO uint32
+
+// [FO] constants.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/shellapi/ns-shellapi-shfileopstructw#members
+const (
+	FO_MOVE FO = iota + 1
+	FO_COPY
+	FO_DELETE
+	FO_RENAME
+)
+
+// FOF represents a set of flags that control a file operation performed by
+// SHFileOperationW.
+type FOF uint16
+
+// [FOF] constants.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/shellapi/ns-shellapi-shfileopstructw#members
+const (
+	FOF_MULTIDESTFILES FOF = 1 << iota
+	FOF_CONFIRMMOUSE
+	FOF_SILENT
+	FOF_RENAMEONCOLLISION
+	FOF_NOCONFIRMATION
+	FOF_WANTMAPPINGHANDLE
+	FOF_ALLOWUNDO
+	FOF_FILESONLY
+	FOF_SIMPLEPROGRESS
+	FOF_NOCONFIRMMKDIR
+	FOF_NOERRORUI
+	FOF_NOCOPYSECURITYATTRIBS
+	FOF_NORECURSION
+	FOF_NO_CONNECTED_ELEMENTS
+	FOF_WANTNUKEWARNING
+	FOF_NORECURSEREPARSE
+)
+
+// SHERB represents a set of flags that control how SHEmptyRecycleBinW empties
+// the Recycle Bin.
+type SHERB uint32
+
+// [SHERB] constants.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/shellapi/nf-shellapi-shemptyrecyclebinw#parameters
+const (
+	SHERB_NOCONFIRMATION SHERB = 1 << iota
+	SHERB_NOPROGRESSUI
+	SHERB_NOSOUND
+)
+
+// SHGFI represents a set of flags that specify the file information to
+// retrieve with SHGetFileInfoW.
+type SHGFI uint32
+
+// [SHGFI] constants.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/shellapi/nf-shellapi-shgetfileinfow#parameters
+const (
+	SHGFI_LARGEICON         SHGFI = 0x00000000
+	SHGFI_SMALLICON         SHGFI = 0x00000001
+	SHGFI_OPENICON          SHGFI = 0x00000002
+	SHGFI_SHELLICONSIZE     SHGFI = 0x00000004
+	SHGFI_PIDL              SHGFI = 0x00000008
+	SHGFI_USEFILEATTRIBUTES SHGFI = 0x00000010
+	SHGFI_ADDOVERLAYS       SHGFI = 0x00000020
+	SHGFI_OVERLAYINDEX      SHGFI = 0x00000040
+	SHGFI_ICON              SHGFI = 0x00000100
+	SHGFI_DISPLAYNAME       SHGFI = 0x00000200
+	SHGFI_TYPENAME          SHGFI = 0x00000400
+	SHGFI_ATTRIBUTES        SHGFI = 0x00000800
+	SHGFI_ICONLOCATION      SHGFI = 0x00001000
+	SHGFI_EXETYPE           SHGFI = 0x00002000
+	SHGFI_SYSICONINDEX      SHGFI = 0x00004000
+	SHGFI_LINKOVERLAY       SHGFI = 0x00008000
+	SHGFI_SELECTED          SHGFI = 0x00010000
+	SHGFI_ATTR_SPECIFIED    SHGFI = 0x00020000
+)
+
+// SHIL represents the system image lists, by icon size, that
+// SHGetImageList can retrieve.
+type SHIL int32
+
+// [SHIL] constants.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/shellapi/nf-shellapi-shgetimagelist#parameters
+const (
+	SHIL_LARGE      SHIL = iota // 32x32, or as configured by the user
+	SHIL_SMALL                  // 16x16, or as configured by the user
+	SHIL_EXTRALARGE             // 48x48, or as configured by the user
+	SHIL_SYSSMALL               // the size of GetSystemMetrics(SM_CXSMICON)
+	SHIL_JUMBO                  // 256x256
+)
+
+// WH represents the type of hook procedure installed by SetWindowsHookExW
+// (partial).
+type WH int32
+
+// [WH] constants (partial).
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-setwindowshookexw#parameters
+const (
+	WH_KEYBOARD_LL WH = 13
+	WH_MOUSE_LL    WH = 14
+)
+
+// HC_ACTION is the hook code passed to a hook procedure when it must process
+// the message.
+const HC_ACTION = 0
+
+// LLKHF represents the flags of a [KBDLLHOOKSTRUCT].
+type LLKHF uint32
+
+// [LLKHF] constants.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-kbdllhookstruct#members
+const (
+	LLKHF_EXTENDED          LLKHF = 0x01
+	LLKHF_LOWER_IL_INJECTED LLKHF = 0x02
+	LLKHF_INJECTED          LLKHF = 0x10
+	LLKHF_ALTDOWN           LLKHF = 0x20
+	LLKHF_UP                LLKHF = 0x80
+)
+
+// LLMHF represents the flags of a [MSLLHOOKSTRUCT].
+type LLMHF uint32
+
+// [LLMHF] constants.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-msllhookstruct#members
+const (
+	LLMHF_INJECTED          LLMHF = 0x01
+	LLMHF_LOWER_IL_INJECTED LLMHF = 0x02
+)
+
+// PM specifies how messages are handled by PeekMessageW.
+type PM uint32
+
+// [PM] constants (partial).
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-peekmessagew#parameters
+const (
+	PM_NOREMOVE PM = 0x0000
+	PM_REMOVE   PM = 0x0001
+	PM_NOYIELD  PM = 0x0002
+)
+
+// HWND_MESSAGE is the parent of message-only windows, which are not visible,
+// have no z-order and receive no broadcast messages.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/winmsg/window-features#message-only-windows
+const HWND_MESSAGE = ^HWND(2) // (HWND)-3
+
+// Z-order positions of SetWindowPos, which places a window after the window
+// given or at the position named.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-setwindowpos#parameters
+const (
+	HWND_TOP       HWND = 0
+	HWND_BOTTOM    HWND = 1
+	HWND_TOPMOST        = ^HWND(0) // (HWND)-1
+	HWND_NOTOPMOST      = ^HWND(1) // (HWND)-2
+)
+
+// RIDEV represents the mode flags of a [RAWINPUTDEVICE].
+type RIDEV uint32
+
+// [RIDEV] constants.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-rawinputdevice#members
+const (
+	RIDEV_REMOVE       RIDEV = 0x00000001
+	RIDEV_EXCLUDE      RIDEV = 0x00000010
+	RIDEV_PAGEONLY     RIDEV = 0x00000020
+	RIDEV_NOLEGACY     RIDEV = 0x00000030
+	RIDEV_INPUTSINK    RIDEV = 0x00000100
+	RIDEV_CAPTUREMOUSE RIDEV = 0x00000200
+	RIDEV_NOHOTKEYS    RIDEV = 0x00000200
+	RIDEV_APPKEYS      RIDEV = 0x00000400
+	RIDEV_EXINPUTSINK  RIDEV = 0x00001000
+	RIDEV_DEVNOTIFY    RIDEV = 0x00002000
+)
+
+// RIDI represents the data retrieved by GetRawInputDeviceInfoW.
+type RIDI uint32
+
+// [RIDI] constants.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getrawinputdeviceinfow#parameters
+const (
+	RIDI_PREPARSEDDATA RIDI = 0x20000005
+	RIDI_DEVICENAME    RIDI = 0x20000007
+	RIDI_DEVICEINFO    RIDI = 0x2000000b
+)
+
+// RID represents the data retrieved by GetRawInputData.
+type RID uint32
+
+// [RID] constants.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getrawinputdata#parameters
+const (
+	RID_INPUT  RID = 0x10000003
+	RID_HEADER RID = 0x10000005
+)
+
+// IntegrityLevel represents the mandatory integrity level of a process, the
+// relative identifier of the integrity SID of its token.
+type IntegrityLevel uint32
+
+// [IntegrityLevel] constants.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/secauthz/well-known-sids
+const (
+	SECURITY_MANDATORY_UNTRUSTED_RID         IntegrityLevel = 0x0000
+	SECURITY_MANDATORY_LOW_RID               IntegrityLevel = 0x1000
+	SECURITY_MANDATORY_MEDIUM_RID            IntegrityLevel = 0x2000
+	SECURITY_MANDATORY_MEDIUM_PLUS_RID       IntegrityLevel = 0x2100
+	SECURITY_MANDATORY_HIGH_RID              IntegrityLevel = 0x3000
+	SECURITY_MANDATORY_SYSTEM_RID            IntegrityLevel = 0x4000
+	SECURITY_MANDATORY_PROTECTED_PROCESS_RID IntegrityLevel = 0x5000
+)
+
+// CWTFlag represents the flags of CreateWaitableTimerExW.
+type CWTFlag uint32
+
+// [CWTFlag] constants.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/synchapi/nf-synchapi-createwaitabletimerexw#parameters
+const (
+	CREATE_WAITABLE_TIMER_MANUAL_RESET    CWTFlag = 0x00000001
+	CREATE_WAITABLE_TIMER_HIGH_RESOLUTION CWTFlag = 0x00000002
+)
+
+// TIMER_ALL_ACCESS is the access right for all operations on a waitable
+// timer.
+const TIMER_ALL_ACCESS = 0x1F0003
+
+// UOI represents the information GetUserObjectInformationW retrieves.
+type UOI int32
+
+// [UOI] constants.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getuserobjectinformationw
+const (
+	UOI_FLAGS    UOI = 1
+	UOI_NAME     UOI = 2
+	UOI_TYPE     UOI = 3
+	UOI_USER_SID UOI = 4
+)
+
+// DESKTOP_READOBJECTS is the access right to read objects on a desktop.
+const DESKTOP_READOBJECTS = 0x0001
+
+// SM represents the system metrics retrieved by GetSystemMetrics.
+type SM int32
+
+// [SM] constants (partial).
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getsystemmetrics
+const (
+	SM_CXSCREEN        SM = 0
+	SM_CYSCREEN        SM = 1
+	SM_XVIRTUALSCREEN  SM = 76
+	SM_YVIRTUALSCREEN  SM = 77
+	SM_CXVIRTUALSCREEN SM = 78
+	SM_CYVIRTUALSCREEN SM = 79
+	SM_CMONITORS       SM = 80
+)
+
+// KLF represents the flags of LoadKeyboardLayoutW and ActivateKeyboardLayout.
+type KLF uint32
+
+// [KLF] constants.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-loadkeyboardlayoutw#parameters
+const (
+	KLF_ACTIVATE      KLF = 0x00000001
+	KLF_SUBSTITUTE_OK KLF = 0x00000002
+	KLF_REORDER       KLF = 0x00000008
+	KLF_REPLACELANG   KLF = 0x00000010
+	KLF_NOTELLSHELL   KLF = 0x00000080
+	KLF_SETFORPROCESS KLF = 0x00000100
+	KLF_SHIFTLOCK     KLF = 0x00010000
+	KLF_RESET         KLF = 0x40000000
+)
+
+// KL_NAMELENGTH is the length of a keyboard layout name, including the
+// terminating null character.
+const KL_NAMELENGTH = 9
+
+// MONITOR represents the monitor MonitorFromWindow and MonitorFromPoint
+// return when no monitor contains the window or point.
+type MONITOR uint32
+
+// [MONITOR] constants.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-monitorfromwindow
+const (
+	MONITOR_DEFAULTTONULL    MONITOR = 0
+	MONITOR_DEFAULTTOPRIMARY MONITOR = 1
+	MONITOR_DEFAULTTONEAREST MONITOR = 2
+)
+
+// MONITORINFOF_PRIMARY is the flag of [MONITORINFOEXW] set for the primary
+// monitor.
+const MONITORINFOF_PRIMARY = 0x00000001
+
+// CCHDEVICENAME is the length of the device name of a [MONITORINFOEXW].
+const CCHDEVICENAME = 32
+
+// A MONITORINFOEXW is a struct that contains information about a display
+// monitor, filled in by GetMonitorInfoW.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-monitorinfoexw
+type MONITORINFOEXW struct {
+	// Size is the size of the struct, in bytes, which must be set before
+	// calling GetMonitorInfoW.
+	Size uint32 // (DWORD)
+
+	// Monitor is the rectangle of the monitor, in virtual-screen coordinates.
+	Monitor RECT
+
+	// Work is the work area of the monitor, in virtual-screen coordinates.
+	Work RECT
+
+	// Flags holds MONITORINFOF_PRIMARY for the primary monitor.
+	Flags uint32 // (DWORD)
+
+	// Device is the device name of the monitor.
+	Device [CCHDEVICENAME]uint16 // (WCHAR)
+}
+
+// MDT represents the type of DPI GetDpiForMonitor retrieves.
+type MDT int32
+
+// [MDT] constants.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/shellscalingapi/ne-shellscalingapi-monitor_dpi_type
+const (
+	MDT_EFFECTIVE_DPI MDT = 0
+	MDT_ANGULAR_DPI   MDT = 1
+	MDT_RAW_DPI       MDT = 2
+)
+
+// DPI_AWARENESS_CONTEXT represents how a thread or process scales with the DPI
+// of the monitors, as set by SetProcessDpiAwarenessContext.
+type DPI_AWARENESS_CONTEXT uintptr
+
+// [DPI_AWARENESS_CONTEXT] constants.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/hidpi/dpi-awareness-context
+const (
+	DPI_AWARENESS_CONTEXT_UNAWARE              DPI_AWARENESS_CONTEXT = ^DPI_AWARENESS_CONTEXT(0) // -1
+	DPI_AWARENESS_CONTEXT_SYSTEM_AWARE         DPI_AWARENESS_CONTEXT = ^DPI_AWARENESS_CONTEXT(1) // -2
+	DPI_AWARENESS_CONTEXT_PER_MONITOR_AWARE    DPI_AWARENESS_CONTEXT = ^DPI_AWARENESS_CONTEXT(2) // -3
+	DPI_AWARENESS_CONTEXT_PER_MONITOR_AWARE_V2 DPI_AWARENESS_CONTEXT = ^DPI_AWARENESS_CONTEXT(3) // -4
+	DPI_AWARENESS_CONTEXT_UNAWARE_GDISCALED    DPI_AWARENESS_CONTEXT = ^DPI_AWARENESS_CONTEXT(4) // -5
+)
+
+// DWMWA represents the window attribute DwmGetWindowAttribute retrieves.
+type DWMWA uint32
+
+// [DWMWA] constants (partial).
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/dwmapi/ne-dwmapi-dwmwindowattribute
+const (
+	DWMWA_NCRENDERING_ENABLED   DWMWA = 1
+	DWMWA_EXTENDED_FRAME_BOUNDS DWMWA = 9
+	DWMWA_CLOAKED               DWMWA = 14
+)
+
+// Errno returns the system error code equivalent to e.
+func (e SEErr) Errno() syscall.Errno {
+	switch e {
+	case 0, SE_ERR_OOM:
+		return windows.ERROR_NOT_ENOUGH_MEMORY
+	case SE_ERR_FNF:
+		return windows.ERROR_FILE_NOT_FOUND
+	case SE_ERR_PNF:
+		return windows.ERROR_PATH_NOT_FOUND
+	case SE_ERR_ACCESSDENIED:
+		return windows.ERROR_ACCESS_DENIED
+	case SE_ERR_BAD_FORMAT:
+		return windows.ERROR_BAD_FORMAT
+	case SE_ERR_SHARE:
+		return windows.ERROR_SHARING_VIOLATION
+	case SE_ERR_ASSOCINCOMPLETE, SE_ERR_NOASSOC:
+		return windows.ERROR_NO_ASSOCIATION
+	case SE_ERR_DDETIMEOUT, SE_ERR_DDEFAIL, SE_ERR_DDEBUSY:
+		return windows.ERROR_DDE_FAIL
+	case SE_ERR_DLLNOTFOUND:
+		return windows.ERROR_DLL_NOT_FOUND
+	}
+
+	return syscall.EINVAL
+}
+
+// Error implements the error interface.
+func (e SEErr) Error() string {
+	return e.Errno().Error()
+}
+
+// Unwrap returns the system error code equivalent to e, so that errors.Is
+// matches it against syscall errors.
+func (e SEErr) Unwrap() error {
+	return e.Errno()
+}
+
 // #endregion
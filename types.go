@@ -0,0 +1,139 @@
+package winapi
+
+// This file contains types and constants that do not depend on a Windows
+// build, so that the portable encoders, decoders and parsers of the package
+// can use them on any platform.
+
+// #region types
+
+// A POINT is a struct that defines x- and y- coordinates of a point.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/windef/ns-windef-point
+type POINT struct {
+	// X is the x-coordinate of the point.
+	X int32 // (LONG)
+
+	// Y is the y-coordinate of the point.
+	Y int32 // (LONG)
+}
+
+// A RECT is a struct that defines a rectangle by the coordinates of its
+// upper-left and lower-right corners. The right and bottom edges are
+// exclusive.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/windef/ns-windef-rect
+type RECT struct {
+	// Left is the x-coordinate of the upper-left corner.
+	Left int32 // (LONG)
+
+	// Top is the y-coordinate of the upper-left corner.
+	Top int32 // (LONG)
+
+	// Right is the x-coordinate of the lower-right corner.
+	Right int32 // (LONG)
+
+	// Bottom is the y-coordinate of the lower-right corner.
+	Bottom int32 // (LONG)
+}
+
+// Width returns the width of r.
+func (r RECT) Width() int32 {
+	return r.Right - r.Left
+}
+
+// Height returns the height of r.
+func (r RECT) Height() int32 {
+	return r.Bottom - r.Top
+}
+
+// Contains reports whether pt is inside r.
+func (r RECT) Contains(pt POINT) bool {
+	return r.Left <= pt.X && pt.X < r.Right && r.Top <= pt.Y && pt.Y < r.Bottom
+}
+
+// A MSG is a struct that contains message information from a thread's message
+// queue.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/ns-winuser-msg
+type MSG struct {
+	// Hwnd is a handle to the window whose procedure receives the message.
+	Hwnd HWND
+
+	// Message is the message identifier.
+	Message uint32 // (UINT)
+
+	// WParam is additional information about the message.
+	WParam uintptr
+
+	// Lparam is additional information about the message.
+	LParam uintptr
+
+	// Time is the time at which the message was posted.
+	Time uint32 // (DWORD)
+
+	// Pt is the cursor position when the message was posted.
+	Pt POINT
+
+	// LPrivate is only declared by the SDK for _MAC builds. It lies in the
+	// trailing padding of MSG on 64-bit Windows, and past the end of it on
+	// 32-bit Windows, so Windows never reads or writes it.
+	LPrivate uint32 // (DWORD)
+}
+
+// SW represents a set of show commands that control how a window is shown.
+type SW int32
+
+// [SW] constants.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-showwindow#parameters
+const (
+	SW_HIDE            SW = 0
+	SW_SHOWNORMAL      SW = 1
+	SW_NORMAL          SW = 1
+	SW_SHOWMINIMIZED   SW = 2
+	SW_SHOWMAXIMIZED   SW = 3
+	SW_MAXIMIZE        SW = 3
+	SW_SHOWNOACTIVATE  SW = 4
+	SW_SHOW            SW = 5
+	SW_MINIMIZE        SW = 6
+	SW_SHOWMINNOACTIVE SW = 7
+	SW_SHOWNA          SW = 8
+	SW_RESTORE         SW = 9
+	SW_SHOWDEFAULT     SW = 10
+	SW_FORCEMINIMIZE   SW = 11
+)
+
+// MK represents a set of flags in the wParam of mouse messages that tell which
+// mouse buttons and modifier keys are down.
+type MK uint32
+
+// [MK] constants.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/inputdev/wm-lbuttondown#parameters
+const (
+	MK_LBUTTON  MK = 0x0001
+	MK_RBUTTON  MK = 0x0002
+	MK_SHIFT    MK = 0x0004
+	MK_CONTROL  MK = 0x0008
+	MK_MBUTTON  MK = 0x0010
+	MK_XBUTTON1 MK = 0x0020
+	MK_XBUTTON2 MK = 0x0040
+)
+
+// A SHQUERYRBINFO is a struct that contains the size and item count
+// information retrieved by SHQueryRecycleBinW. On 32-bit Windows, shellapi.h
+// packs it to 1-byte boundaries, which the 4-byte alignment of int64 matches.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/shellapi/ns-shellapi-shqueryrbinfo
+type SHQUERYRBINFO struct {
+	// CbSize is the size of the struct, in bytes.
+	CbSize uint32 // (DWORD)
+
+	// Size is the total size of all the objects in the Recycle Bin, in bytes.
+	Size int64 // (__int64)
+
+	// NumItems is the total number of items in the Recycle Bin.
+	NumItems int64 // (__int64)
+}
+
+// #endregion
@@ -4,32 +4,112 @@ package winapi
 
 import (
 	"syscall"
+	"time"
 	"unsafe"
+
+	"golang.org/x/sys/windows"
 )
 
 var (
-	user32                  = syscall.NewLazyDLL("user32.dll")
-	procAttachThreadInput   = user32.NewProc("AttachThreadInput")
-	procBlockInput          = user32.NewProc("BlockInput")
-	procDispatchMessage     = user32.NewProc("DispatchMessage")
-	procBringWindowToTop    = user32.NewProc("BringWindowToTop")
-	procGetKeyState         = user32.NewProc("GetKeyState")
-	procGetMessage          = user32.NewProc("GetMessageW")
-	procGetParent           = user32.NewProc("GetParent")
-	procGetWindowLongPtrW   = user32.NewProc("GetWindowLongPtrW")
-	procMapVirtualKeyW      = user32.NewProc("MapVirtualKeyW")
-	procMapVirtualKeyExW    = user32.NewProc("MapVirtualKeyExW")
-	procPostMessageW        = user32.NewProc("PostMessageW")
-	procPostThreadMessageW  = user32.NewProc("PostThreadMessageW")
-	procSendInput           = user32.NewProc("SendInput")
-	procSetFocus            = user32.NewProc("SetFocus")
-	procSetForegroundWindow = user32.NewProc("SetForegroundWindow")
-	procSetWinEventHook     = user32.NewProc("SetWinEventHook")
-	procTranslateMessage    = user32.NewProc("TranslateMessage")
-	procUnhookWinEvent      = user32.NewProc("UnhookWinEvent")
-	procVkKeyScanExW        = user32.NewProc("VkKeyScanExW")
+	user32                                     = syscall.NewLazyDLL("user32.dll")
+	procActivateKeyboardLayout                 = user32.NewProc("ActivateKeyboardLayout")
+	procAttachThreadInput                      = user32.NewProc("AttachThreadInput")
+	procBlockInput                             = user32.NewProc("BlockInput")
+	procCallNextHookEx                         = user32.NewProc("CallNextHookEx")
+	procChangeDisplaySettingsExW               = user32.NewProc("ChangeDisplaySettingsExW")
+	procClientToScreen                         = user32.NewProc("ClientToScreen")
+	procCloseDesktop                           = user32.NewProc("CloseDesktop")
+	procCreateWindowExW                        = user32.NewProc("CreateWindowExW")
+	procDefWindowProcW                         = user32.NewProc("DefWindowProcW")
+	procDestroyIcon                            = user32.NewProc("DestroyIcon")
+	procDestroyWindow                          = user32.NewProc("DestroyWindow")
+	procDispatchMessage                        = user32.NewProc("DispatchMessageW")
+	procBringWindowToTop                       = user32.NewProc("BringWindowToTop")
+	procEnumDisplayDevicesW                    = user32.NewProc("EnumDisplayDevicesW")
+	procEnumDisplayMonitors                    = user32.NewProc("EnumDisplayMonitors")
+	procEnumDisplaySettingsExW                 = user32.NewProc("EnumDisplaySettingsExW")
+	procGetAsyncKeyState                       = user32.NewProc("GetAsyncKeyState")
+	procGetClientRect                          = user32.NewProc("GetClientRect")
+	procGetDC                                  = user32.NewProc("GetDC")
+	procGetDpiForWindow                        = user32.NewProc("GetDpiForWindow")
+	procGetIconInfo                            = user32.NewProc("GetIconInfo")
+	procGetKeyboardLayout                      = user32.NewProc("GetKeyboardLayout")
+	procGetKeyboardLayoutList                  = user32.NewProc("GetKeyboardLayoutList")
+	procGetKeyboardLayoutNameW                 = user32.NewProc("GetKeyboardLayoutNameW")
+	procGetKeyboardState                       = user32.NewProc("GetKeyboardState")
+	procGetKeyNameTextW                        = user32.NewProc("GetKeyNameTextW")
+	procGetKeyState                            = user32.NewProc("GetKeyState")
+	procGetMessage                             = user32.NewProc("GetMessageW")
+	procGetMessageExtraInfo                    = user32.NewProc("GetMessageExtraInfo")
+	procGetMonitorInfoW                        = user32.NewProc("GetMonitorInfoW")
+	procGetParent                              = user32.NewProc("GetParent")
+	procGetRawInputBuffer                      = user32.NewProc("GetRawInputBuffer")
+	procGetRawInputData                        = user32.NewProc("GetRawInputData")
+	procGetRawInputDeviceInfoW                 = user32.NewProc("GetRawInputDeviceInfoW")
+	procGetRawInputDeviceList                  = user32.NewProc("GetRawInputDeviceList")
+	procGetSystemMetrics                       = user32.NewProc("GetSystemMetrics")
+	procGetThreadDesktop                       = user32.NewProc("GetThreadDesktop")
+	procGetUserObjectInformationW              = user32.NewProc("GetUserObjectInformationW")
+	procGetWindowLongPtrW                      = user32.NewProc("GetWindowLongPtrW")
+	procGetWindowPlacement                     = user32.NewProc("GetWindowPlacement")
+	procGetWindowRect                          = user32.NewProc("GetWindowRect")
+	procLoadKeyboardLayoutW                    = user32.NewProc("LoadKeyboardLayoutW")
+	procLogicalToPhysicalPointForPerMonitorDPI = user32.NewProc("LogicalToPhysicalPointForPerMonitorDPI")
+	procMapVirtualKeyW                         = user32.NewProc("MapVirtualKeyW")
+	procMapVirtualKeyExW                       = user32.NewProc("MapVirtualKeyExW")
+	procMonitorFromPoint                       = user32.NewProc("MonitorFromPoint")
+	procMonitorFromWindow                      = user32.NewProc("MonitorFromWindow")
+	procMoveWindow                             = user32.NewProc("MoveWindow")
+	procOpenInputDesktop                       = user32.NewProc("OpenInputDesktop")
+	procPeekMessageW                           = user32.NewProc("PeekMessageW")
+	procPhysicalToLogicalPointForPerMonitorDPI = user32.NewProc("PhysicalToLogicalPointForPerMonitorDPI")
+	procPostMessageW                           = user32.NewProc("PostMessageW")
+	procPostThreadMessageW                     = user32.NewProc("PostThreadMessageW")
+	procRegisterClassExW                       = user32.NewProc("RegisterClassExW")
+	procRegisterRawInputDevices                = user32.NewProc("RegisterRawInputDevices")
+	procReleaseDC                              = user32.NewProc("ReleaseDC")
+	procScreenToClient                         = user32.NewProc("ScreenToClient")
+	procSendInput                              = user32.NewProc("SendInput")
+	procSetFocus                               = user32.NewProc("SetFocus")
+	procSetForegroundWindow                    = user32.NewProc("SetForegroundWindow")
+	procSetProcessDpiAwarenessContext          = user32.NewProc("SetProcessDpiAwarenessContext")
+	procSetWindowPlacement                     = user32.NewProc("SetWindowPlacement")
+	procSetWindowPos                           = user32.NewProc("SetWindowPos")
+	procSetWindowsHookExW                      = user32.NewProc("SetWindowsHookExW")
+	procSetWinEventHook                        = user32.NewProc("SetWinEventHook")
+	procShowWindow                             = user32.NewProc("ShowWindow")
+	procToAsciiEx                              = user32.NewProc("ToAsciiEx")
+	procToUnicodeEx                            = user32.NewProc("ToUnicodeEx")
+	procTranslateMessage                       = user32.NewProc("TranslateMessage")
+	procUnhookWindowsHookEx                    = user32.NewProc("UnhookWindowsHookEx")
+	procUnhookWinEvent                         = user32.NewProc("UnhookWinEvent")
+	procUnloadKeyboardLayout                   = user32.NewProc("UnloadKeyboardLayout")
+	procUnregisterClassW                       = user32.NewProc("UnregisterClassW")
+	procVkKeyScanExW                           = user32.NewProc("VkKeyScanExW")
 )
 
+// ActivateKeyboardLayout sets the input locale identifier of the calling
+// thread, or of the calling process with KLF_SETFORPROCESS. hkl may also be
+// HKL_NEXT or HKL_PREV to cycle through the loaded layouts.
+// It returns 0 with an error if the call fails, or the previous [HKL] with no
+// error on success.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-activatekeyboardlayout
+//
+// Experimental: ActivateKeyboardLayout has not been tested or used internally.
+func ActivateKeyboardLayout(hkl HKL, flags KLF) (HKL, error) {
+	r1, _, err := procActivateKeyboardLayout.Call(uintptr(hkl), uintptr(flags))
+	if r1 == 0 {
+		if err != syscall.Errno(0) {
+			return 0, err
+		}
+
+		return 0, syscall.EINVAL
+	}
+
+	return HKL(r1), nil
+}
+
 // AttachThreadInput attaches or detaches the input processing mechanism of one
 // thread to that of another thread.
 // It returns an error if the call fails.
@@ -84,12 +164,476 @@ func BringWindowToTop(hwnd HWND) error {
 	return nil
 }
 
+// CallNextHookEx passes the hook information to the next hook procedure in the
+// current hook chain.
+// It returns the value returned by the next hook procedure, which the current
+// hook procedure should return in turn.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-callnexthookex
+//
+// Experimental: CallNextHookEx has not been tested or used internally.
+func CallNextHookEx(hook Handle, code int32, wParam, lParam uintptr) uintptr {
+	r1, _, _ := procCallNextHookEx.Call(
+		uintptr(hook),
+		uintptr(code),
+		wParam,
+		lParam,
+	)
+
+	return r1
+}
+
+// ChangeDisplaySettingsExW changes the display mode of the display device to
+// mode, or to the mode stored in the registry if mode is nil. device is the
+// name of a display adapter, or "" for the primary one.
+// It returns nil on success, or the [DISP_CHANGE] result as an error.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-changedisplaysettingsexw
+//
+// Experimental: ChangeDisplaySettingsExW has not been tested or used internally.
+func ChangeDisplaySettingsExW(device string, mode *DEVMODEW, flags CDS) error {
+	name, err := displayDeviceName(device)
+	if err != nil {
+		return err
+	}
+
+	r1, _, _ := procChangeDisplaySettingsExW.Call(
+		uintptr(unsafe.Pointer(name)),
+		uintptr(unsafe.Pointer(mode)),
+		0,
+		uintptr(flags),
+		0,
+	)
+	if r := DISP_CHANGE(int32(r1)); r != DISP_CHANGE_SUCCESSFUL {
+		return r
+	}
+
+	return nil
+}
+
+// ClientToScreen converts pt from the client coordinates of the window hwnd
+// to screen coordinates, in place.
+// It returns an error if the call fails.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-clienttoscreen
+//
+// Experimental: ClientToScreen has not been tested or used internally.
+func ClientToScreen(hwnd HWND, pt *POINT) error {
+	if r1, _, _ := procClientToScreen.Call(uintptr(hwnd), uintptr(unsafe.Pointer(pt))); r1 == 0 {
+		return syscall.EINVAL
+	}
+
+	return nil
+}
+
+// CloseDesktop closes an open handle to a desktop object.
+// It returns an error if the call fails.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-closedesktop
+//
+// Experimental: CloseDesktop has not been tested or used internally.
+func CloseDesktop(desktop Handle) error {
+	if r1, _, err := procCloseDesktop.Call(uintptr(desktop)); r1 == 0 {
+		if err != syscall.Errno(0) {
+			return err
+		}
+
+		return syscall.EINVAL
+	}
+
+	return nil
+}
+
+// CreateWindowExW creates an overlapped, pop-up, child or message-only window
+// of the class className, registered with RegisterClassExW.
+// It returns 0 with an error if the call fails, or a [HWND] with no error on
+// success.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createwindowexw
+//
+// Experimental: CreateWindowExW has not been tested or used internally.
+func CreateWindowExW(exStyle uint32, className, windowName string, style WS, x, y, width, height int32, parent HWND, menu, instance Handle, param uintptr) (HWND, error) {
+	cls, err := syscall.UTF16PtrFromString(className)
+	if err != nil {
+		return 0, err
+	}
+
+	name, err := syscall.UTF16PtrFromString(windowName)
+	if err != nil {
+		return 0, err
+	}
+
+	r1, _, err := procCreateWindowExW.Call(
+		uintptr(exStyle),
+		uintptr(unsafe.Pointer(cls)),
+		uintptr(unsafe.Pointer(name)),
+		uintptr(style),
+		uintptr(x),
+		uintptr(y),
+		uintptr(width),
+		uintptr(height),
+		uintptr(parent),
+		uintptr(menu),
+		uintptr(instance),
+		param,
+	)
+	if r1 == 0 {
+		if err != syscall.Errno(0) {
+			return 0, err
+		}
+
+		return 0, syscall.EINVAL
+	}
+
+	return HWND(r1), nil
+}
+
+// DefWindowProcW calls the default window procedure to provide default
+// processing for any window messages that an application does not process.
+// It returns the result of the message processing.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-defwindowprocw
+//
+// Experimental: DefWindowProcW has not been tested or used internally.
+func DefWindowProcW(hwnd HWND, msg MsgId, wParam, lParam uintptr) uintptr {
+	r1, _, _ := procDefWindowProcW.Call(
+		uintptr(hwnd),
+		uintptr(msg),
+		wParam,
+		lParam,
+	)
+
+	return r1
+}
+
+// DestroyIcon destroys an icon and frees any memory the icon occupied.
+// It returns an error if the call fails.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-destroyicon
+//
+// Experimental: DestroyIcon has not been tested or used internally.
+func DestroyIcon(icon Handle) error {
+	if r1, _, err := procDestroyIcon.Call(uintptr(icon)); r1 == 0 {
+		if err != syscall.Errno(0) {
+			return err
+		}
+
+		return syscall.EINVAL
+	}
+
+	return nil
+}
+
+// DestroyWindow destroys the specified window. It must be called from the
+// thread that created the window.
+// It returns an error if the call fails.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-destroywindow
+//
+// Experimental: DestroyWindow has not been tested or used internally.
+func DestroyWindow(hwnd HWND) error {
+	if r1, _, err := procDestroyWindow.Call(uintptr(hwnd)); r1 == 0 {
+		if err != syscall.Errno(0) {
+			return err
+		}
+
+		return syscall.EINVAL
+	}
+
+	return nil
+}
+
 // DispatchMessage dispatches a message to a window procedure.
 //
 // See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-dispatchmessage
 func DispatchMessage(msg MSG) {
-	_, _, _ = procDispatchMessage.Call(uintptr(unsafe.Pointer(&msg)))
-	// return value is intentionally ignored
+	dispatchMessage(&msg)
+}
+
+// EnumDisplayDevicesW retrieves the display adapter with the index i, if
+// device is "", or the i-th monitor attached to the adapter device. The Size
+// member of dd is filled in.
+// It returns an error if there is no such device.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-enumdisplaydevicesw
+//
+// Experimental: EnumDisplayDevicesW has not been tested or used internally.
+func EnumDisplayDevicesW(device string, i uint32, dd *DISPLAY_DEVICEW, flags uint32) error {
+	name, err := displayDeviceName(device)
+	if err != nil {
+		return err
+	}
+
+	dd.Size = uint32(unsafe.Sizeof(*dd))
+	if r1, _, _ := procEnumDisplayDevicesW.Call(
+		uintptr(unsafe.Pointer(name)),
+		uintptr(i),
+		uintptr(unsafe.Pointer(dd)),
+		uintptr(flags),
+	); r1 == 0 {
+		return syscall.EINVAL
+	}
+
+	return nil
+}
+
+// EnumDisplayMonitors calls fn for each display monitor that intersects clip,
+// or the whole virtual screen if clip is nil, and hdc, if not 0. The procedure
+// fn must be a callback created with [syscall.NewCallback] that takes the
+// monitor, its device context, a pointer to its [RECT] and data, and returns
+// nonzero to continue.
+// It returns an error if the call fails or fn stops the enumeration.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-enumdisplaymonitors
+//
+// Experimental: EnumDisplayMonitors has not been tested or used internally.
+func EnumDisplayMonitors(hdc Handle, clip *RECT, fn uintptr, data uintptr) error {
+	if r1, _, _ := procEnumDisplayMonitors.Call(
+		uintptr(hdc),
+		uintptr(unsafe.Pointer(clip)),
+		fn,
+		data,
+	); r1 == 0 {
+		return syscall.EINVAL
+	}
+
+	return nil
+}
+
+// EnumDisplaySettingsExW retrieves the display mode with the index i of the
+// display adapter device, or "" for the primary one, or its current mode with
+// ENUM_CURRENT_SETTINGS. The Size member of mode is filled in.
+// It returns an error if there is no such mode.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-enumdisplaysettingsexw
+//
+// Experimental: EnumDisplaySettingsExW has not been tested or used internally.
+func EnumDisplaySettingsExW(device string, i uint32, mode *DEVMODEW, flags EDS) error {
+	name, err := displayDeviceName(device)
+	if err != nil {
+		return err
+	}
+
+	mode.Size = uint16(unsafe.Sizeof(*mode))
+	if r1, _, _ := procEnumDisplaySettingsExW.Call(
+		uintptr(unsafe.Pointer(name)),
+		uintptr(i),
+		uintptr(unsafe.Pointer(mode)),
+		uintptr(flags),
+	); r1 == 0 {
+		return syscall.EINVAL
+	}
+
+	return nil
+}
+
+// GetAsyncKeyState determines whether a key is up or down at the time the
+// function is called, regardless of the message queue of the calling thread.
+// It returns true if the key is currently down.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getasynckeystate
+//
+// Experimental: GetAsyncKeyState has not been tested or used internally.
+func GetAsyncKeyState(virtKey byte) (down bool) {
+	r1, _, _ := procGetAsyncKeyState.Call(uintptr(virtKey))
+
+	return int16(r1) < 0
+}
+
+// GetClientRect retrieves the client area of the window hwnd, whose
+// upper-left corner is always (0, 0).
+// It returns an error if the call fails.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getclientrect
+//
+// Experimental: GetClientRect has not been tested or used internally.
+func GetClientRect(hwnd HWND) (RECT, error) {
+	var r RECT
+	if r1, _, err := procGetClientRect.Call(uintptr(hwnd), uintptr(unsafe.Pointer(&r))); r1 == 0 {
+		if err != syscall.Errno(0) {
+			return RECT{}, err
+		}
+
+		return RECT{}, syscall.EINVAL
+	}
+
+	return r, nil
+}
+
+// GetDC retrieves a handle to a device context for the client area of the
+// specified window, or for the entire screen if hwnd is 0. The device context
+// must be released with [ReleaseDC].
+// It returns 0 with an error if the call fails, or a [Handle] with no error on
+// success.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getdc
+//
+// Experimental: GetDC has not been tested or used internally.
+func GetDC(hwnd HWND) (Handle, error) {
+	r1, _, _ := procGetDC.Call(uintptr(hwnd))
+	if r1 == 0 {
+		return 0, syscall.EINVAL
+	}
+
+	return Handle(r1), nil
+}
+
+// GetDpiForWindow retrieves the DPI of the window hwnd, which depends on its
+// DPI awareness: the DPI of its monitor if it is per-monitor aware, the system
+// DPI if it is system aware, or USER_DEFAULT_SCREEN_DPI if it is unaware.
+// It returns 0 with an error if hwnd is not a window or the call is not
+// supported (Windows 10, version 1607 and later).
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getdpiforwindow
+//
+// Experimental: GetDpiForWindow has not been tested or used internally.
+func GetDpiForWindow(hwnd HWND) (uint32, error) {
+	if err := procGetDpiForWindow.Find(); err != nil {
+		return 0, err
+	}
+
+	r1, _, _ := procGetDpiForWindow.Call(uintptr(hwnd))
+	if r1 == 0 {
+		return 0, syscall.EINVAL
+	}
+
+	return uint32(r1), nil
+}
+
+// GetIconInfo retrieves information about the specified icon or cursor into
+// info. The caller must delete the bitmaps in info with DeleteObject.
+// It returns an error if the call fails.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-geticoninfo
+//
+// Experimental: GetIconInfo has not been tested or used internally.
+func GetIconInfo(icon Handle, info *ICONINFO) error {
+	if r1, _, err := procGetIconInfo.Call(
+		uintptr(icon),
+		uintptr(unsafe.Pointer(info)),
+	); r1 == 0 {
+		if err != syscall.Errno(0) {
+			return err
+		}
+
+		return syscall.EINVAL
+	}
+
+	return nil
+}
+
+// GetKeyboardLayout retrieves the active input locale identifier of the
+// specified thread, or of the calling thread if threadId is 0.
+// It returns the [HKL], or 0 if the thread does not exist.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getkeyboardlayout
+//
+// Experimental: GetKeyboardLayout has not been tested or used internally.
+func GetKeyboardLayout(threadId uint32) HKL {
+	r1, _, _ := procGetKeyboardLayout.Call(uintptr(threadId))
+
+	return HKL(r1)
+}
+
+// GetKeyboardLayoutList retrieves the input locale identifiers of the input
+// languages loaded in the system, in the order of the language bar.
+// It returns an error if the call fails.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getkeyboardlayoutlist
+//
+// Experimental: GetKeyboardLayoutList has not been tested or used internally.
+func GetKeyboardLayoutList() ([]HKL, error) {
+	for {
+		r1, _, err := procGetKeyboardLayoutList.Call(0, 0)
+		if r1 == 0 {
+			if err != syscall.Errno(0) {
+				return nil, err
+			}
+
+			return nil, nil
+		}
+
+		list := make([]HKL, r1)
+		r1, _, err = procGetKeyboardLayoutList.Call(uintptr(len(list)), uintptr(unsafe.Pointer(&list[0])))
+		if r1 == 0 {
+			if err != syscall.Errno(0) {
+				return nil, err
+			}
+
+			// A layout was unloaded in between.
+			continue
+		}
+
+		return list[:r1], nil
+	}
+}
+
+// GetKeyboardLayoutNameW retrieves the name of the active keyboard layout of
+// the calling thread.
+// It returns the [KLID] of the layout, or an error if the call fails.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getkeyboardlayoutnamew
+//
+// Experimental: GetKeyboardLayoutNameW has not been tested or used internally.
+func GetKeyboardLayoutNameW() (KLID, error) {
+	var name [KL_NAMELENGTH]uint16
+	if r1, _, err := procGetKeyboardLayoutNameW.Call(uintptr(unsafe.Pointer(&name[0]))); r1 == 0 {
+		if err != syscall.Errno(0) {
+			return 0, err
+		}
+
+		return 0, syscall.EINVAL
+	}
+
+	return ParseKLID(windows.UTF16ToString(name[:]))
+}
+
+// GetKeyboardState copies the status of the 256 virtual keys, as the calling
+// thread's message queue last saw them, into state. The high bit of an entry
+// is set if the key is down, and the low bit if it is toggled on.
+// It returns an error if the call fails.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getkeyboardstate
+//
+// Experimental: GetKeyboardState has not been tested or used internally.
+func GetKeyboardState(state *[256]byte) error {
+	if r1, _, err := procGetKeyboardState.Call(uintptr(unsafe.Pointer(state))); r1 == 0 {
+		if err != syscall.Errno(0) {
+			return err
+		}
+
+		return syscall.EINVAL
+	}
+
+	return nil
+}
+
+// GetKeyNameTextW retrieves the display name of a key, such as "Strg" for the
+// left Ctrl key on a German layout, in the language of the keyboard layout of
+// the calling thread. lParam holds the scan code in bits 16-23 and the
+// extended-key flag in bit 24, as in the lParam of WM_KEYDOWN; set bit 25 to
+// give left and right keys the same name.
+// It returns an empty string with an error if the call fails.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getkeynametextw
+//
+// Experimental: GetKeyNameTextW has not been tested or used internally.
+func GetKeyNameTextW(lParam int32) (string, error) {
+	var name [64]uint16
+	r1, _, err := procGetKeyNameTextW.Call(
+		uintptr(lParam),
+		uintptr(unsafe.Pointer(&name[0])),
+		uintptr(len(name)),
+	)
+	if r1 == 0 {
+		if err != syscall.Errno(0) {
+			return "", err
+		}
+
+		return "", syscall.EINVAL
+	}
+
+	return windows.UTF16ToString(name[:r1]), nil
 }
 
 // GetKeyState retrieves the status of the specified virtual key by specifying
@@ -114,21 +658,37 @@ func GetKeyState(virtKey byte) (down bool, toggled bool) {
 //
 // See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getmessage
 func GetMessage(msg MSG, hwnd HWND, msgFilterMin, msgFilterMax MsgId) (uintptr, error) {
-	r1, _, err := procGetMessage.Call(
-		uintptr(unsafe.Pointer(&msg)),
-		uintptr(hwnd),
-		uintptr(msgFilterMin),
-		uintptr(msgFilterMax),
-	)
-	if int(r1) == -1 {
-		if err != syscall.Errno(0) {
-			return r1, err
-		}
+	return getMessage(&msg, hwnd, msgFilterMin, msgFilterMax)
+}
 
-		return r1, syscall.EINVAL
+// GetMessageExtraInfo retrieves the extra message information of the last
+// message retrieved by the calling thread's GetMessage, such as the ExtraInfo
+// of an injected input event.
+// It returns the extra information, which [IsOwnInjection] can check.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getmessageextrainfo
+//
+// Experimental: GetMessageExtraInfo has not been tested or used internally.
+func GetMessageExtraInfo() uintptr {
+	r1, _, _ := procGetMessageExtraInfo.Call()
+
+	return r1
+}
+
+// GetMonitorInfoW retrieves information about the display monitor hmon into
+// info, whose Size it sets.
+// It returns an error if the call fails.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getmonitorinfow
+//
+// Experimental: GetMonitorInfoW has not been tested or used internally.
+func GetMonitorInfoW(hmon Handle, info *MONITORINFOEXW) error {
+	info.Size = uint32(unsafe.Sizeof(*info))
+	if r1, _, _ := procGetMonitorInfoW.Call(uintptr(hmon), uintptr(unsafe.Pointer(info))); r1 == 0 {
+		return syscall.EINVAL
 	}
 
-	return r1, nil
+	return nil
 }
 
 // GetParent retrieves a handle to the specified window's parent/owner.
@@ -151,6 +711,195 @@ func GetParent(hwnd HWND) (HWND, error) {
 	return HWND(r1), nil
 }
 
+// GetRawInputBuffer reads the pending raw input of the calling thread into
+// data, whose size in bytes is *size. If data is nil, *size is set to the
+// minimum size needed for one RAWINPUT struct. The structs read can be decoded
+// with [DecodeRawInputBuffer].
+// It returns 0 with an error if the call fails, or the number of RAWINPUT
+// structs read with no error on success.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getrawinputbuffer
+//
+// Experimental: GetRawInputBuffer has not been tested or used internally.
+func GetRawInputBuffer(data unsafe.Pointer, size *uint32) (uint32, error) {
+	r1, _, err := procGetRawInputBuffer.Call(
+		uintptr(data),
+		uintptr(unsafe.Pointer(size)),
+		uintptr(rawInputHeaderSize),
+	)
+	if int32(r1) == -1 {
+		if err != syscall.Errno(0) {
+			return 0, err
+		}
+
+		return 0, syscall.EINVAL
+	}
+
+	return uint32(r1), nil
+}
+
+// GetRawInputData retrieves the raw input identified by rawInput, the lParam
+// of a WM_INPUT message, into data, whose size in bytes is *size. If data is
+// nil, *size is set to the size needed. The RAWINPUT struct read with
+// RID_INPUT can be decoded with [DecodeRawInput].
+// It returns 0 with an error if the call fails, or the number of bytes read
+// with no error on success.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getrawinputdata
+//
+// Experimental: GetRawInputData has not been tested or used internally.
+func GetRawInputData(rawInput Handle, command RID, data unsafe.Pointer, size *uint32) (uint32, error) {
+	r1, _, err := procGetRawInputData.Call(
+		uintptr(rawInput),
+		uintptr(command),
+		uintptr(data),
+		uintptr(unsafe.Pointer(size)),
+		uintptr(rawInputHeaderSize),
+	)
+	if int32(r1) == -1 {
+		if err != syscall.Errno(0) {
+			return 0, err
+		}
+
+		return 0, syscall.EINVAL
+	}
+
+	return uint32(r1), nil
+}
+
+// GetRawInputDeviceInfoW retrieves information about the raw input device into
+// data, whose size is *size: in characters for RIDI_DEVICENAME, in bytes
+// otherwise. If data is nil, *size is set to the size needed.
+// It returns 0 with an error if the call fails, or the size of the data read
+// with no error on success.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getrawinputdeviceinfow
+//
+// Experimental: GetRawInputDeviceInfoW has not been tested or used internally.
+func GetRawInputDeviceInfoW(device Handle, command RIDI, data unsafe.Pointer, size *uint32) (uint32, error) {
+	r1, _, err := procGetRawInputDeviceInfoW.Call(
+		uintptr(device),
+		uintptr(command),
+		uintptr(data),
+		uintptr(unsafe.Pointer(size)),
+	)
+	if int32(r1) < 0 {
+		if err != syscall.Errno(0) {
+			return 0, err
+		}
+
+		return 0, syscall.EINVAL
+	}
+
+	return uint32(r1), nil
+}
+
+// GetRawInputDeviceList enumerates the raw input devices attached to the
+// system.
+// It returns nil with an error if the call fails.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getrawinputdevicelist
+//
+// Experimental: GetRawInputDeviceList has not been tested or used internally.
+func GetRawInputDeviceList() ([]RAWINPUTDEVICELIST, error) {
+	elemSize := unsafe.Sizeof(RAWINPUTDEVICELIST{})
+	for {
+		var n uint32
+		if r1, _, err := procGetRawInputDeviceList.Call(
+			0,
+			uintptr(unsafe.Pointer(&n)),
+			elemSize,
+		); int32(r1) == -1 {
+			return nil, err
+		}
+		if n == 0 {
+			return nil, nil
+		}
+
+		list := make([]RAWINPUTDEVICELIST, n)
+		r1, _, err := procGetRawInputDeviceList.Call(
+			uintptr(unsafe.Pointer(&list[0])),
+			uintptr(unsafe.Pointer(&n)),
+			elemSize,
+		)
+		if int32(r1) != -1 {
+			return list[:r1], nil
+		}
+
+		// A device may have been attached between the two calls.
+		if err != windows.ERROR_INSUFFICIENT_BUFFER {
+			return nil, err
+		}
+	}
+}
+
+// GetSystemMetrics retrieves the specified system metric or configuration
+// setting, such as the size of the screen in pixels.
+// It returns the metric, or 0 if index is invalid.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getsystemmetrics
+//
+// Experimental: GetSystemMetrics has not been tested or used internally.
+func GetSystemMetrics(index SM) int32 {
+	r1, _, _ := procGetSystemMetrics.Call(uintptr(index))
+
+	return int32(r1)
+}
+
+// GetThreadDesktop retrieves a handle to the desktop assigned to the specified
+// thread. The handle does not need to be closed.
+// It returns 0 with an error if the call fails, or a [Handle] to the desktop
+// with no error on success.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getthreaddesktop
+//
+// Experimental: GetThreadDesktop has not been tested or used internally.
+func GetThreadDesktop(threadId uint32) (Handle, error) {
+	r1, _, err := procGetThreadDesktop.Call(uintptr(threadId))
+	if r1 == 0 {
+		if err != syscall.Errno(0) {
+			return 0, err
+		}
+
+		return 0, syscall.EINVAL
+	}
+
+	return Handle(r1), nil
+}
+
+// GetUserObjectInformationW retrieves information about the specified window
+// station or desktop object into buf.
+// It returns the size of the information in bytes, with an error if the call
+// fails, such as ERROR_INSUFFICIENT_BUFFER if buf is too small.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getuserobjectinformationw
+//
+// Experimental: GetUserObjectInformationW has not been tested or used internally.
+func GetUserObjectInformationW(obj Handle, index UOI, buf []byte) (uint32, error) {
+	var p unsafe.Pointer
+	if len(buf) > 0 {
+		p = unsafe.Pointer(&buf[0])
+	}
+
+	var needed uint32
+	r1, _, err := procGetUserObjectInformationW.Call(
+		uintptr(obj),
+		uintptr(index),
+		uintptr(p),
+		uintptr(len(buf)),
+		uintptr(unsafe.Pointer(&needed)),
+	)
+	if r1 == 0 {
+		if err != syscall.Errno(0) {
+			return needed, err
+		}
+
+		return needed, syscall.EINVAL
+	}
+
+	return needed, nil
+}
+
 // GetWindowLongPtrW retrieves information about the specified window.
 // It returns 0 with an error if the call fails, or the requested value with no
 // error on success.
@@ -174,6 +923,94 @@ func GetWindowLongPtrW(hwnd HWND, index GWL) (uintptr, error) {
 	return r1, nil
 }
 
+// GetWindowPlacement retrieves the show state and the restored, minimized
+// and maximized positions of the window hwnd.
+// It returns an error if the call fails.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getwindowplacement
+//
+// Experimental: GetWindowPlacement has not been tested or used internally.
+func GetWindowPlacement(hwnd HWND) (WINDOWPLACEMENT, error) {
+	wp := WINDOWPLACEMENT{Length: uint32(unsafe.Sizeof(WINDOWPLACEMENT{}))}
+	if r1, _, err := procGetWindowPlacement.Call(uintptr(hwnd), uintptr(unsafe.Pointer(&wp))); r1 == 0 {
+		if err != syscall.Errno(0) {
+			return WINDOWPLACEMENT{}, err
+		}
+
+		return WINDOWPLACEMENT{}, syscall.EINVAL
+	}
+
+	return wp, nil
+}
+
+// GetWindowRect retrieves the bounding rectangle of the window hwnd, in
+// screen coordinates, including the invisible resize borders of Windows 10
+// and later; see DWMWA_EXTENDED_FRAME_BOUNDS for the visible frame.
+// It returns an error if the call fails.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getwindowrect
+//
+// Experimental: GetWindowRect has not been tested or used internally.
+func GetWindowRect(hwnd HWND) (RECT, error) {
+	var r RECT
+	if r1, _, err := procGetWindowRect.Call(uintptr(hwnd), uintptr(unsafe.Pointer(&r))); r1 == 0 {
+		if err != syscall.Errno(0) {
+			return RECT{}, err
+		}
+
+		return RECT{}, syscall.EINVAL
+	}
+
+	return r, nil
+}
+
+// LoadKeyboardLayoutW loads a keyboard layout into the system, and activates
+// it for the calling thread with KLF_ACTIVATE.
+// It returns 0 with an error if the call fails, or the [HKL] of the layout
+// with no error on success.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-loadkeyboardlayoutw
+//
+// Experimental: LoadKeyboardLayoutW has not been tested or used internally.
+func LoadKeyboardLayoutW(klid KLID, flags KLF) (HKL, error) {
+	name, err := windows.UTF16PtrFromString(klid.String())
+	if err != nil {
+		return 0, err
+	}
+
+	r1, _, err := procLoadKeyboardLayoutW.Call(uintptr(unsafe.Pointer(name)), uintptr(flags))
+	if r1 == 0 {
+		if err != syscall.Errno(0) {
+			return 0, err
+		}
+
+		return 0, syscall.EINVAL
+	}
+
+	return HKL(r1), nil
+}
+
+// LogicalToPhysicalPointForPerMonitorDPI converts pt from the logical
+// coordinates of the window hwnd, as seen by a DPI unaware thread, to physical
+// screen coordinates, in place.
+// It returns an error if the call fails or is not supported (Windows 8.1 and
+// later).
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-logicaltophysicalpointforpermonitordpi
+//
+// Experimental: LogicalToPhysicalPointForPerMonitorDPI has not been tested or used internally.
+func LogicalToPhysicalPointForPerMonitorDPI(hwnd HWND, pt *POINT) error {
+	if err := procLogicalToPhysicalPointForPerMonitorDPI.Find(); err != nil {
+		return err
+	}
+
+	if r1, _, _ := procLogicalToPhysicalPointForPerMonitorDPI.Call(uintptr(hwnd), uintptr(unsafe.Pointer(pt))); r1 == 0 {
+		return syscall.EINVAL
+	}
+
+	return nil
+}
+
 // MapVirtualKeyW translates a virtual-key code into a scan code or character
 // value, or translates a scan code into a virtual-key code.
 // It returns 0 with an error if the call fails, or the translated key code with
@@ -188,30 +1025,155 @@ func MapVirtualKeyW(code uint32, mapType MapVKType) (uint32, error) {
 		uintptr(mapType),
 	)
 	if r1 == 0 {
-		return 0, err
+		return 0, err
+	}
+
+	return uint32(r1), nil
+}
+
+// MapVirtualKeyExW translates a virtual-key code into a scan code or character
+// value, or translates a scan code into a virtual-key code. It translates the
+// codes using the input language and an input locale identifer.
+// It returns 0 with an error if the call fails, or the translated key code with
+// no error on success.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-mapvirtualkeyexw
+func MapVirtualKeyExW(code uint32, mapType MapVKType, hkl Handle) (uint32, error) {
+	r1, _, err := procMapVirtualKeyExW.Call(
+		uintptr(code),
+		uintptr(mapType),
+		uintptr(hkl),
+	)
+	if r1 == 0 {
+		return 0, err
+	}
+
+	return uint32(r1), nil
+}
+
+// MonitorFromPoint retrieves the display monitor that contains pt, in screen
+// coordinates, or the monitor selected by flags if none does.
+// It returns the HMONITOR, or 0 if there is none and flags is
+// MONITOR_DEFAULTTONULL.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-monitorfrompoint
+//
+// Experimental: MonitorFromPoint has not been tested or used internally.
+func MonitorFromPoint(pt POINT, flags MONITOR) Handle {
+	var r1 uintptr
+	if unsafe.Sizeof(uintptr(0)) == 8 {
+		// A POINT is passed by value in a single register on 64-bit Windows.
+		r1, _, _ = procMonitorFromPoint.Call(uintptr(uint32(pt.X))|uintptr(uint32(pt.Y))<<32, uintptr(flags))
+	} else {
+		r1, _, _ = procMonitorFromPoint.Call(uintptr(pt.X), uintptr(pt.Y), uintptr(flags))
+	}
+
+	return Handle(r1)
+}
+
+// MonitorFromWindow retrieves the display monitor that has the largest area
+// of intersection with the window hwnd, or the monitor selected by flags if
+// none does.
+// It returns the HMONITOR, or 0 if there is none and flags is
+// MONITOR_DEFAULTTONULL.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-monitorfromwindow
+//
+// Experimental: MonitorFromWindow has not been tested or used internally.
+func MonitorFromWindow(hwnd HWND, flags MONITOR) Handle {
+	r1, _, _ := procMonitorFromWindow.Call(uintptr(hwnd), uintptr(flags))
+
+	return Handle(r1)
+}
+
+// MoveWindow changes the position and size of the window hwnd, in screen
+// coordinates for a top-level window or client coordinates of the parent for
+// a child window.
+// It returns an error if the call fails.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-movewindow
+//
+// Experimental: MoveWindow has not been tested or used internally.
+func MoveWindow(hwnd HWND, x, y, width, height int32, repaint bool) error {
+	if r1, _, err := procMoveWindow.Call(
+		uintptr(hwnd),
+		uintptr(x),
+		uintptr(y),
+		uintptr(width),
+		uintptr(height),
+		uintptr(toBOOL(repaint)),
+	); r1 == 0 {
+		if err != syscall.Errno(0) {
+			return err
+		}
+
+		return syscall.EINVAL
+	}
+
+	return nil
+}
+
+// OpenInputDesktop opens the desktop that receives user input, which differs
+// from the desktop of the calling thread while, e.g., the secure desktop of the
+// lock screen or a UAC prompt is shown.
+// It returns 0 with an error if the call fails, or a [Handle] to the desktop
+// with no error on success. The handle must be closed with [CloseDesktop].
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-openinputdesktop
+//
+// Experimental: OpenInputDesktop has not been tested or used internally.
+func OpenInputDesktop(flags uint32, inherit bool, access uint32) (Handle, error) {
+	r1, _, err := procOpenInputDesktop.Call(uintptr(flags), uintptr(toBOOL(inherit)), uintptr(access))
+	if r1 == 0 {
+		if err != syscall.Errno(0) {
+			return 0, err
+		}
+
+		return 0, syscall.EINVAL
 	}
 
-	return uint32(r1), nil
+	return Handle(r1), nil
 }
 
-// MapVirtualKeyExW translates a virtual-key code into a scan code or character
-// value, or translates a scan code into a virtual-key code. It translates the
-// codes using the input language and an input locale identifer.
-// It returns 0 with an error if the call fails, or the translated key code with
-// no error on success.
+// PeekMessageW checks the calling thread's message queue for a message and
+// retrieves it into msg, if any. Calling PeekMessageW also creates the
+// thread's message queue if it does not exist yet.
+// It returns true if a message is available.
 //
-// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-mapvirtualkeyexw
-func MapVirtualKeyExW(code uint32, mapType MapVKType, hkl Handle) (uint32, error) {
-	r1, _, err := procMapVirtualKeyExW.Call(
-		uintptr(code),
-		uintptr(mapType),
-		uintptr(hkl),
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-peekmessagew
+//
+// Experimental: PeekMessageW has not been tested or used internally.
+func PeekMessageW(msg *MSG, hwnd HWND, msgFilterMin, msgFilterMax MsgId, removeMsg PM) bool {
+	r1, _, _ := procPeekMessageW.Call(
+		uintptr(unsafe.Pointer(msg)),
+		uintptr(hwnd),
+		uintptr(msgFilterMin),
+		uintptr(msgFilterMax),
+		uintptr(removeMsg),
 	)
-	if r1 == 0 {
-		return 0, err
+
+	return r1 != 0
+}
+
+// PhysicalToLogicalPointForPerMonitorDPI converts pt from physical screen
+// coordinates to the logical coordinates of the window hwnd, as seen by a DPI
+// unaware thread, in place.
+// It returns an error if the call fails or is not supported (Windows 8.1 and
+// later).
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-physicaltologicalpointforpermonitordpi
+//
+// Experimental: PhysicalToLogicalPointForPerMonitorDPI has not been tested or used internally.
+func PhysicalToLogicalPointForPerMonitorDPI(hwnd HWND, pt *POINT) error {
+	if err := procPhysicalToLogicalPointForPerMonitorDPI.Find(); err != nil {
+		return err
 	}
 
-	return uint32(r1), nil
+	if r1, _, _ := procPhysicalToLogicalPointForPerMonitorDPI.Call(uintptr(hwnd), uintptr(unsafe.Pointer(pt))); r1 == 0 {
+		return syscall.EINVAL
+	}
+
+	return nil
 }
 
 // PostMessageW posts a message in the message queue for the specified window
@@ -258,20 +1220,44 @@ func PostThreadMessageW(idThread uint32, msg MsgId, wParam, lParam uintptr) erro
 	return nil
 }
 
-// SendInput synthesizes keystrokes, mouse motions, and button clicks through
-// the provided inputs. SendInput can only send a slice of one type at a time.
+// RegisterClassExW registers a window class for use in calls to
+// [CreateWindowExW]. The Size member of wc is filled in.
+// It returns 0 with an error if the call fails, or the class atom with no
+// error on success.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-registerclassexw
+//
+// Experimental: RegisterClassExW has not been tested or used internally.
+func RegisterClassExW(wc *WNDCLASSEXW) (uint16, error) {
+	wc.Size = uint32(unsafe.Sizeof(*wc))
+	r1, _, err := procRegisterClassExW.Call(uintptr(unsafe.Pointer(wc)))
+	if r1 == 0 {
+		if err != syscall.Errno(0) {
+			return 0, err
+		}
+
+		return 0, syscall.EINVAL
+	}
+
+	return uint16(r1), nil
+}
+
+// RegisterRawInputDevices registers the devices that supply the raw input
+// data, delivered as WM_INPUT messages.
 // It returns an error if the call fails.
 //
-// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-sendinput
-func SendInput[T INPUT_Mi | INPUT_Ki | INPUT_Hi](inputs []T) error {
-	if len(inputs) == 0 {
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-registerrawinputdevices
+//
+// Experimental: RegisterRawInputDevices has not been tested or used internally.
+func RegisterRawInputDevices(devices []RAWINPUTDEVICE) error {
+	if len(devices) == 0 {
 		return nil
 	}
 
-	if r1, _, err := procSendInput.Call(
-		uintptr(len(inputs)),
-		uintptr(unsafe.Pointer(&inputs[0])),
-		uintptr(unsafe.Sizeof(inputs[0])),
+	if r1, _, err := procRegisterRawInputDevices.Call(
+		uintptr(unsafe.Pointer(&devices[0])),
+		uintptr(len(devices)),
+		unsafe.Sizeof(devices[0]),
 	); r1 == 0 {
 		if err != syscall.Errno(0) {
 			return err
@@ -283,6 +1269,90 @@ func SendInput[T INPUT_Mi | INPUT_Ki | INPUT_Hi](inputs []T) error {
 	return nil
 }
 
+// ReleaseDC releases a device context retrieved by [GetDC].
+// It returns an error if the call fails.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-releasedc
+//
+// Experimental: ReleaseDC has not been tested or used internally.
+func ReleaseDC(hwnd HWND, hdc Handle) error {
+	if r1, _, _ := procReleaseDC.Call(uintptr(hwnd), uintptr(hdc)); r1 == 0 {
+		return syscall.EINVAL
+	}
+
+	return nil
+}
+
+// ScreenToClient converts pt from screen coordinates to the client
+// coordinates of the window hwnd, in place.
+// It returns an error if the call fails.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-screentoclient
+//
+// Experimental: ScreenToClient has not been tested or used internally.
+func ScreenToClient(hwnd HWND, pt *POINT) error {
+	if r1, _, _ := procScreenToClient.Call(uintptr(hwnd), uintptr(unsafe.Pointer(pt))); r1 == 0 {
+		return syscall.EINVAL
+	}
+
+	return nil
+}
+
+// SendInput synthesizes keystrokes, mouse motions, and button clicks through
+// the provided inputs. The events of one call are inserted serially into the
+// input stream, without being interleaved with other input, so a sequence
+// such as Ctrl+click should be sent as a single slice of [INPUT], which can
+// mix event types; the other input types send a slice of one type at a time.
+// If [EnableInjectionTag] is on, mouse and keyboard inputs without an
+// ExtraInfo are sent with the [InjectionTag]; inputs is left unchanged.
+// It returns an error if the call fails. Use [SendInputN] to also detect
+// events that were not inserted.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-sendinput
+func SendInput[T INPUT | INPUT_Mi | INPUT_Ki | INPUT_Hi](inputs []T) error {
+	if n, err := sendInput(inputs); n == 0 && err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SendInputN is like [SendInput], but reports how many of the events were
+// inserted. If fewer than all of them were, e.g. because the input desktop
+// changed, the remaining events are sent again up to retries times; the
+// retried events are no longer atomic with those sent before them.
+//
+// Windows reports events blocked by User Interface Privilege Isolation (UIPI)
+// as inserted, so SendInputN also compares the integrity level of the process
+// owning the foreground window with its own: if it is higher, the events
+// likely never reached it.
+// It returns the number of events inserted, and a [*PartialInputError] if
+// that is short or the events were likely blocked by UIPI.
+//
+// Experimental: SendInputN has not been tested or used internally.
+func SendInputN[T INPUT | INPUT_Mi | INPUT_Ki | INPUT_Hi](inputs []T, retries int) (int, error) {
+	sent := 0
+	for attempt := 0; ; attempt++ {
+		n, err := sendInput(inputs[sent:])
+		sent += n
+
+		blocked := uipiBlocked()
+		if sent == len(inputs) && !blocked {
+			return sent, nil
+		}
+		if sent == len(inputs) || blocked || attempt >= retries {
+			return sent, &PartialInputError{
+				Sent:    sent,
+				Total:   len(inputs),
+				Blocked: blocked,
+				Err:     err,
+			}
+		}
+
+		time.Sleep(time.Duration(attempt+1) * 10 * time.Millisecond)
+	}
+}
+
 // SetFocus sets the keyboard focus to the specified window, as long as the
 // window is attached to the calling thread's message queue.
 // It returns 0 with an error if the call fails, or a [HWND] with no error on
@@ -315,6 +1385,109 @@ func SetForegroundWindow(hwnd HWND) error {
 	return nil
 }
 
+// SetProcessDpiAwarenessContext sets the DPI awareness of the calling
+// process, which must be set before it creates windows and cannot be changed
+// once set, by this call or the application manifest.
+// It returns an error if the call fails, such as ERROR_ACCESS_DENIED if the
+// awareness is already set, or is not supported (Windows 10, version 1703 and
+// later).
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-setprocessdpiawarenesscontext
+//
+// Experimental: SetProcessDpiAwarenessContext has not been tested or used internally.
+func SetProcessDpiAwarenessContext(ctx DPI_AWARENESS_CONTEXT) error {
+	if err := procSetProcessDpiAwarenessContext.Find(); err != nil {
+		return err
+	}
+
+	if r1, _, err := procSetProcessDpiAwarenessContext.Call(uintptr(ctx)); r1 == 0 {
+		if err != syscall.Errno(0) {
+			return err
+		}
+
+		return syscall.EINVAL
+	}
+
+	return nil
+}
+
+// SetWindowPlacement sets the show state and the restored, minimized and
+// maximized positions of the window hwnd. The Length member of wp is filled
+// in.
+// It returns an error if the call fails.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-setwindowplacement
+//
+// Experimental: SetWindowPlacement has not been tested or used internally.
+func SetWindowPlacement(hwnd HWND, wp *WINDOWPLACEMENT) error {
+	wp.Length = uint32(unsafe.Sizeof(*wp))
+	if r1, _, err := procSetWindowPlacement.Call(uintptr(hwnd), uintptr(unsafe.Pointer(wp))); r1 == 0 {
+		if err != syscall.Errno(0) {
+			return err
+		}
+
+		return syscall.EINVAL
+	}
+
+	return nil
+}
+
+// SetWindowPos changes the position, size and Z order of the window hwnd,
+// placing it after insertAfter or at HWND_TOP, HWND_BOTTOM, HWND_TOPMOST or
+// HWND_NOTOPMOST. flags selects what is changed, such as SWP_NOZORDER to keep
+// the Z order.
+// It returns an error if the call fails.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-setwindowpos
+//
+// Experimental: SetWindowPos has not been tested or used internally.
+func SetWindowPos(hwnd, insertAfter HWND, x, y, width, height int32, flags SWP) error {
+	if r1, _, err := procSetWindowPos.Call(
+		uintptr(hwnd),
+		uintptr(insertAfter),
+		uintptr(x),
+		uintptr(y),
+		uintptr(width),
+		uintptr(height),
+		uintptr(flags),
+	); r1 == 0 {
+		if err != syscall.Errno(0) {
+			return err
+		}
+
+		return syscall.EINVAL
+	}
+
+	return nil
+}
+
+// SetWindowsHookExW installs an application-defined hook procedure into a hook
+// chain. The procedure fn must be a callback created with
+// [syscall.NewCallback].
+// It returns 0 with an error if the call fails, or a [Handle] to the hook with
+// no error on success.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-setwindowshookexw
+//
+// Experimental: SetWindowsHookExW has not been tested or used internally.
+func SetWindowsHookExW(idHook WH, fn uintptr, hmod Handle, threadId uint32) (Handle, error) {
+	r1, _, err := procSetWindowsHookExW.Call(
+		uintptr(idHook),
+		fn,
+		uintptr(hmod),
+		uintptr(threadId),
+	)
+	if r1 == 0 {
+		if err != syscall.Errno(0) {
+			return 0, err
+		}
+
+		return 0, syscall.EINVAL
+	}
+
+	return Handle(r1), nil
+}
+
 // SetWinEventHook sets an event hook function for a range of events.
 // It returns 0 with an error if the call fails, or a [Handle] with no error on success.
 //
@@ -340,6 +1513,82 @@ func SetWinEventHook(eventMin WEvent, eventMax WEvent, hmodWinEventProc, pfnWinE
 	return Handle(r1), nil
 }
 
+// ShowWindow sets the show state of the window hwnd, such as SW_MINIMIZE or
+// SW_RESTORE.
+// It returns true if the window was visible before the call.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-showwindow
+//
+// Experimental: ShowWindow has not been tested or used internally.
+func ShowWindow(hwnd HWND, cmd SW) bool {
+	r1, _, _ := procShowWindow.Call(uintptr(hwnd), uintptr(cmd))
+
+	return r1 != 0
+}
+
+// ToAsciiEx translates the virtual-key code vk and scan code scan, with the
+// keyboard state state, to the characters they produce in the ANSI code page
+// with the layout hkl. Set bit 0 of flags if a menu is active. The high bit of
+// scan is set for a key release.
+// It returns up to 2 characters, or the spacing character of a dead key with
+// dead set to true, in which case the dead key is stored in the keyboard state
+// of the calling thread and combines with the next key it translates.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-toasciiex
+//
+// Experimental: ToAsciiEx has not been tested or used internally.
+func ToAsciiEx(vk, scan uint32, state *[256]byte, flags uint32, hkl HKL) (chars []byte, dead bool) {
+	var buf [2]byte
+	r1, _, _ := procToAsciiEx.Call(
+		uintptr(vk),
+		uintptr(scan),
+		uintptr(unsafe.Pointer(state)),
+		uintptr(unsafe.Pointer(&buf[0])),
+		uintptr(flags),
+		uintptr(hkl),
+	)
+
+	switch n := int32(r1); {
+	case n < 0:
+		return buf[:1], true
+	case n > 2:
+		return buf[:], false
+	default:
+		return buf[:n], false
+	}
+}
+
+// ToUnicodeEx translates the virtual-key code vk and scan code scan, with the
+// keyboard state state, to the UTF-16 characters they produce with the layout
+// hkl, into buf. Set bit 0 of flags if a menu is active, and bit 2 to leave
+// the keyboard state of the calling thread, including its dead-key buffer,
+// unchanged (Windows 10, version 1607 and later). The high bit of scan is set
+// for a key release.
+// It returns the number of characters written to buf, 0 if the key produces
+// none, or a negative number if the key is a dead key, whose spacing character
+// is written to buf.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-tounicodeex
+//
+// Experimental: ToUnicodeEx has not been tested or used internally.
+func ToUnicodeEx(vk, scan uint32, state *[256]byte, buf []uint16, flags uint32, hkl HKL) int32 {
+	if len(buf) == 0 {
+		return 0
+	}
+
+	r1, _, _ := procToUnicodeEx.Call(
+		uintptr(vk),
+		uintptr(scan),
+		uintptr(unsafe.Pointer(state)),
+		uintptr(unsafe.Pointer(&buf[0])),
+		uintptr(len(buf)),
+		uintptr(flags),
+		uintptr(hkl),
+	)
+
+	return int32(r1)
+}
+
 // TranslateMessage translates virtual-key messages into character messages.
 // It returns an error if the call fails.
 //
@@ -352,6 +1601,25 @@ func TranslateMessage(msg MSG) error {
 	return nil
 }
 
+// UnhookWindowsHookEx removes a hook procedure installed by
+// [SetWindowsHookExW].
+// It returns an error if the call fails.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-unhookwindowshookex
+//
+// Experimental: UnhookWindowsHookEx has not been tested or used internally.
+func UnhookWindowsHookEx(hook Handle) error {
+	if r1, _, err := procUnhookWindowsHookEx.Call(uintptr(hook)); r1 == 0 {
+		if err != syscall.Errno(0) {
+			return err
+		}
+
+		return syscall.EINVAL
+	}
+
+	return nil
+}
+
 // UnhookWinEvent removes an event hook function created by a previous call to
 // [SetWinEventHook].
 // It returns an error if the call fails.
@@ -365,6 +1633,52 @@ func UnhookWinEvent(winEventHook Handle) error {
 	return nil
 }
 
+// UnloadKeyboardLayout unloads an input locale identifier loaded by
+// LoadKeyboardLayoutW.
+// It returns an error if the call fails, such as when the layout is in use.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-unloadkeyboardlayout
+//
+// Experimental: UnloadKeyboardLayout has not been tested or used internally.
+func UnloadKeyboardLayout(hkl HKL) error {
+	if r1, _, err := procUnloadKeyboardLayout.Call(uintptr(hkl)); r1 == 0 {
+		if err != syscall.Errno(0) {
+			return err
+		}
+
+		return syscall.EINVAL
+	}
+
+	return nil
+}
+
+// UnregisterClassW unregisters a window class registered with
+// [RegisterClassExW], once all windows of the class are destroyed.
+// It returns an error if the call fails.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-unregisterclassw
+//
+// Experimental: UnregisterClassW has not been tested or used internally.
+func UnregisterClassW(className string, instance Handle) error {
+	cls, err := syscall.UTF16PtrFromString(className)
+	if err != nil {
+		return err
+	}
+
+	if r1, _, err := procUnregisterClassW.Call(
+		uintptr(unsafe.Pointer(cls)),
+		uintptr(instance),
+	); r1 == 0 {
+		if err != syscall.Errno(0) {
+			return err
+		}
+
+		return syscall.EINVAL
+	}
+
+	return nil
+}
+
 // VkKeyScanExW translates a character to the corresponding virtual-key code and
 // shift state. It translates the character using the input language and
 // physical keyboard layout identifed by the input locale identifier.
@@ -387,3 +1701,82 @@ func VkKeyScanExW(ch int16, hkl Handle) (code byte, shift byte, err error) {
 
 	return code, shift, nil
 }
+
+// dispatchMessage is [DispatchMessage] for a message retrieved in place by
+// getMessage.
+func dispatchMessage(msg *MSG) {
+	_, _, _ = procDispatchMessage.Call(uintptr(unsafe.Pointer(msg)))
+	// return value is intentionally ignored
+}
+
+// displayDeviceName returns the name of the display device for the display
+// functions, or nil for "", which selects the primary adapter.
+func displayDeviceName(device string) (*uint16, error) {
+	if device == "" {
+		return nil, nil
+	}
+
+	return syscall.UTF16PtrFromString(device)
+}
+
+// getMessage is [GetMessage] retrieving the message into msg, for the
+// package's own message loops.
+func getMessage(msg *MSG, hwnd HWND, msgFilterMin, msgFilterMax MsgId) (uintptr, error) {
+	r1, _, err := procGetMessage.Call(
+		uintptr(unsafe.Pointer(msg)),
+		uintptr(hwnd),
+		uintptr(msgFilterMin),
+		uintptr(msgFilterMax),
+	)
+	if int32(r1) == -1 {
+		if err != syscall.Errno(0) {
+			return r1, err
+		}
+
+		return r1, syscall.EINVAL
+	}
+
+	return r1, nil
+}
+
+// sendInput stamps inputs with the [InjectionTag] if needed and sends them.
+// It returns the number of events inserted, and the last error if none were.
+func sendInput[T INPUT | INPUT_Mi | INPUT_Ki | INPUT_Hi](inputs []T) (int, error) {
+	if len(inputs) == 0 {
+		return 0, nil
+	}
+
+	if injectionTagOn.Load() {
+		inputs = append([]T(nil), inputs...)
+		for i := range inputs {
+			switch in := any(&inputs[i]).(type) {
+			case *INPUT:
+				switch in.Type {
+				case INPUT_MOUSE:
+					stampExtraInfo(&in.Mi().ExtraInfo)
+				case INPUT_KEYBOARD:
+					stampExtraInfo(&in.Ki().ExtraInfo)
+				}
+			case *INPUT_Mi:
+				stampExtraInfo(&in.Mi.ExtraInfo)
+			case *INPUT_Ki:
+				stampExtraInfo(&in.Ki.ExtraInfo)
+			}
+		}
+	}
+
+	r1, _, err := procSendInput.Call(
+		uintptr(len(inputs)),
+		uintptr(unsafe.Pointer(&inputs[0])),
+		uintptr(unsafe.Sizeof(inputs[0])),
+	)
+	if r1 == 0 {
+		if err != syscall.Errno(0) {
+			return 0, err
+		}
+
+		return 0, syscall.EINVAL
+	}
+
+	return int(r1), nil
+}
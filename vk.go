@@ -0,0 +1,326 @@
+package winapi
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// #region constants
+
+// Virtual-key codes. The keys 0-9 and A-Z have the codes of the ASCII
+// characters '0'-'9' and 'A'-'Z', which have no constants.
+//
+// See: https://learn.microsoft.com/en-us/windows/win32/inputdev/virtual-key-codes
+const (
+	VK_LBUTTON             = 0x01
+	VK_RBUTTON             = 0x02
+	VK_CANCEL              = 0x03
+	VK_MBUTTON             = 0x04
+	VK_XBUTTON1            = 0x05
+	VK_XBUTTON2            = 0x06
+	VK_BACK                = 0x08
+	VK_TAB                 = 0x09
+	VK_CLEAR               = 0x0C
+	VK_RETURN              = 0x0D
+	VK_SHIFT               = 0x10
+	VK_CONTROL             = 0x11
+	VK_MENU                = 0x12
+	VK_PAUSE               = 0x13
+	VK_CAPITAL             = 0x14
+	VK_KANA                = 0x15
+	VK_HANGUL              = 0x15
+	VK_IME_ON              = 0x16
+	VK_JUNJA               = 0x17
+	VK_FINAL               = 0x18
+	VK_HANJA               = 0x19
+	VK_KANJI               = 0x19
+	VK_IME_OFF             = 0x1A
+	VK_ESCAPE              = 0x1B
+	VK_CONVERT             = 0x1C
+	VK_NONCONVERT          = 0x1D
+	VK_ACCEPT              = 0x1E
+	VK_MODECHANGE          = 0x1F
+	VK_SPACE               = 0x20
+	VK_PRIOR               = 0x21
+	VK_NEXT                = 0x22
+	VK_END                 = 0x23
+	VK_HOME                = 0x24
+	VK_LEFT                = 0x25
+	VK_UP                  = 0x26
+	VK_RIGHT               = 0x27
+	VK_DOWN                = 0x28
+	VK_SELECT              = 0x29
+	VK_PRINT               = 0x2A
+	VK_EXECUTE             = 0x2B
+	VK_SNAPSHOT            = 0x2C
+	VK_INSERT              = 0x2D
+	VK_DELETE              = 0x2E
+	VK_HELP                = 0x2F
+	VK_LWIN                = 0x5B
+	VK_RWIN                = 0x5C
+	VK_APPS                = 0x5D
+	VK_SLEEP               = 0x5F
+	VK_NUMPAD0             = 0x60
+	VK_NUMPAD1             = 0x61
+	VK_NUMPAD2             = 0x62
+	VK_NUMPAD3             = 0x63
+	VK_NUMPAD4             = 0x64
+	VK_NUMPAD5             = 0x65
+	VK_NUMPAD6             = 0x66
+	VK_NUMPAD7             = 0x67
+	VK_NUMPAD8             = 0x68
+	VK_NUMPAD9             = 0x69
+	VK_MULTIPLY            = 0x6A
+	VK_ADD                 = 0x6B
+	VK_SEPARATOR           = 0x6C
+	VK_SUBTRACT            = 0x6D
+	VK_DECIMAL             = 0x6E
+	VK_DIVIDE              = 0x6F
+	VK_F1                  = 0x70
+	VK_F2                  = 0x71
+	VK_F3                  = 0x72
+	VK_F4                  = 0x73
+	VK_F5                  = 0x74
+	VK_F6                  = 0x75
+	VK_F7                  = 0x76
+	VK_F8                  = 0x77
+	VK_F9                  = 0x78
+	VK_F10                 = 0x79
+	VK_F11                 = 0x7A
+	VK_F12                 = 0x7B
+	VK_F13                 = 0x7C
+	VK_F14                 = 0x7D
+	VK_F15                 = 0x7E
+	VK_F16                 = 0x7F
+	VK_F17                 = 0x80
+	VK_F18                 = 0x81
+	VK_F19                 = 0x82
+	VK_F20                 = 0x83
+	VK_F21                 = 0x84
+	VK_F22                 = 0x85
+	VK_F23                 = 0x86
+	VK_F24                 = 0x87
+	VK_NUMLOCK             = 0x90
+	VK_SCROLL              = 0x91
+	VK_LSHIFT              = 0xA0
+	VK_RSHIFT              = 0xA1
+	VK_LCONTROL            = 0xA2
+	VK_RCONTROL            = 0xA3
+	VK_LMENU               = 0xA4
+	VK_RMENU               = 0xA5
+	VK_BROWSER_BACK        = 0xA6
+	VK_BROWSER_FORWARD     = 0xA7
+	VK_BROWSER_REFRESH     = 0xA8
+	VK_BROWSER_STOP        = 0xA9
+	VK_BROWSER_SEARCH      = 0xAA
+	VK_BROWSER_FAVORITES   = 0xAB
+	VK_BROWSER_HOME        = 0xAC
+	VK_VOLUME_MUTE         = 0xAD
+	VK_VOLUME_DOWN         = 0xAE
+	VK_VOLUME_UP           = 0xAF
+	VK_MEDIA_NEXT_TRACK    = 0xB0
+	VK_MEDIA_PREV_TRACK    = 0xB1
+	VK_MEDIA_STOP          = 0xB2
+	VK_MEDIA_PLAY_PAUSE    = 0xB3
+	VK_LAUNCH_MAIL         = 0xB4
+	VK_LAUNCH_MEDIA_SELECT = 0xB5
+	VK_LAUNCH_APP1         = 0xB6
+	VK_LAUNCH_APP2         = 0xB7
+	VK_OEM_1               = 0xBA
+	VK_OEM_PLUS            = 0xBB
+	VK_OEM_COMMA           = 0xBC
+	VK_OEM_MINUS           = 0xBD
+	VK_OEM_PERIOD          = 0xBE
+	VK_OEM_2               = 0xBF
+	VK_OEM_3               = 0xC0
+	VK_OEM_4               = 0xDB
+	VK_OEM_5               = 0xDC
+	VK_OEM_6               = 0xDD
+	VK_OEM_7               = 0xDE
+	VK_OEM_8               = 0xDF
+	VK_OEM_102             = 0xE2
+	VK_PROCESSKEY          = 0xE5
+	VK_PACKET              = 0xE7
+	VK_UNASSIGNED          = 0xE8 // the last unassigned virtual key code
+	VK_ATTN                = 0xF6
+	VK_CRSEL               = 0xF7
+	VK_EXSEL               = 0xF8
+	VK_EREOF               = 0xF9
+	VK_PLAY                = 0xFA
+	VK_ZOOM                = 0xFB
+	VK_NONAME              = 0xFC
+	VK_PA1                 = 0xFD
+	VK_OEM_CLEAR           = 0xFE
+)
+
+// #endregion
+
+// #region key events
+
+// extendedVK reports whether vk is an extended key, whose events need
+// KEYEVENTF_EXTENDEDKEY to be told apart from the numeric keypad and the left
+// modifiers.
+func extendedVK(vk uint16) bool {
+	switch vk {
+	case VK_RCONTROL, VK_RMENU, VK_LWIN, VK_RWIN, VK_APPS,
+		VK_INSERT, VK_DELETE, VK_HOME, VK_END, VK_PRIOR, VK_NEXT,
+		VK_LEFT, VK_UP, VK_RIGHT, VK_DOWN,
+		VK_NUMLOCK, VK_DIVIDE, VK_SNAPSHOT:
+		return true
+	}
+
+	return false
+}
+
+// keyEvent returns the down or up event of the virtual key vk, flagged as
+// extended if needed.
+func keyEvent(vk uint16, up bool) KEYBDINPUT {
+	ki := KEYBDINPUT{Vk: vk}
+	if extendedVK(vk) {
+		ki.Flags |= KEYEVENTF_EXTENDEDKEY
+	}
+	if up {
+		ki.Flags |= KEYEVENTF_KEYUP
+	}
+
+	return ki
+}
+
+// #endregion
+
+// #region key names
+
+// vkNames are the key names of macros, case-insensitive. The first name of a
+// virtual key is the one it is formatted with. The keys 0-9, A-Z and F1-F24
+// are named by vkByName itself.
+var vkNames = []struct {
+	name string
+	vk   uint16
+}{
+	{"Backspace", VK_BACK},
+	{"Tab", VK_TAB},
+	{"Clear", VK_CLEAR},
+	{"Enter", VK_RETURN},
+	{"Return", VK_RETURN},
+	{"Shift", VK_SHIFT},
+	{"Ctrl", VK_CONTROL},
+	{"Control", VK_CONTROL},
+	{"Alt", VK_MENU},
+	{"Pause", VK_PAUSE},
+	{"CapsLock", VK_CAPITAL},
+	{"Esc", VK_ESCAPE},
+	{"Escape", VK_ESCAPE},
+	{"Space", VK_SPACE},
+	{"PageUp", VK_PRIOR},
+	{"PgUp", VK_PRIOR},
+	{"PageDown", VK_NEXT},
+	{"PgDn", VK_NEXT},
+	{"End", VK_END},
+	{"Home", VK_HOME},
+	{"Left", VK_LEFT},
+	{"Up", VK_UP},
+	{"Right", VK_RIGHT},
+	{"Down", VK_DOWN},
+	{"PrintScreen", VK_SNAPSHOT},
+	{"PrtSc", VK_SNAPSHOT},
+	{"Insert", VK_INSERT},
+	{"Ins", VK_INSERT},
+	{"Delete", VK_DELETE},
+	{"Del", VK_DELETE},
+	{"LWin", VK_LWIN},
+	{"Win", VK_LWIN},
+	{"RWin", VK_RWIN},
+	{"Apps", VK_APPS},
+	{"Sleep", VK_SLEEP},
+	{"Num0", VK_NUMPAD0},
+	{"Num1", VK_NUMPAD1},
+	{"Num2", VK_NUMPAD2},
+	{"Num3", VK_NUMPAD3},
+	{"Num4", VK_NUMPAD4},
+	{"Num5", VK_NUMPAD5},
+	{"Num6", VK_NUMPAD6},
+	{"Num7", VK_NUMPAD7},
+	{"Num8", VK_NUMPAD8},
+	{"Num9", VK_NUMPAD9},
+	{"NumMultiply", VK_MULTIPLY},
+	{"NumAdd", VK_ADD},
+	{"NumSeparator", VK_SEPARATOR},
+	{"NumSubtract", VK_SUBTRACT},
+	{"NumDecimal", VK_DECIMAL},
+	{"NumDivide", VK_DIVIDE},
+	{"NumLock", VK_NUMLOCK},
+	{"ScrollLock", VK_SCROLL},
+	{"LShift", VK_LSHIFT},
+	{"RShift", VK_RSHIFT},
+	{"LCtrl", VK_LCONTROL},
+	{"LControl", VK_LCONTROL},
+	{"RCtrl", VK_RCONTROL},
+	{"RControl", VK_RCONTROL},
+	{"LAlt", VK_LMENU},
+	{"RAlt", VK_RMENU},
+	{"AltGr", VK_RMENU},
+	{"VolumeMute", VK_VOLUME_MUTE},
+	{"VolumeDown", VK_VOLUME_DOWN},
+	{"VolumeUp", VK_VOLUME_UP},
+	{"MediaNext", VK_MEDIA_NEXT_TRACK},
+	{"MediaPrev", VK_MEDIA_PREV_TRACK},
+	{"MediaStop", VK_MEDIA_STOP},
+	{"MediaPlayPause", VK_MEDIA_PLAY_PAUSE},
+	{"Semicolon", VK_OEM_1},
+	{"Plus", VK_OEM_PLUS},
+	{"Comma", VK_OEM_COMMA},
+	{"Minus", VK_OEM_MINUS},
+	{"Period", VK_OEM_PERIOD},
+	{"Slash", VK_OEM_2},
+	{"Backtick", VK_OEM_3},
+	{"LBracket", VK_OEM_4},
+	{"Backslash", VK_OEM_5},
+	{"RBracket", VK_OEM_6},
+	{"Quote", VK_OEM_7},
+}
+
+// vkByName returns the virtual key named name, which is case-insensitive and
+// may also be a code from 0x01 to 0xFE in hexadecimal.
+func vkByName(name string) (uint16, bool) {
+	switch n := strings.ToUpper(name); {
+	case len(n) == 1 && ('0' <= n[0] && n[0] <= '9' || 'A' <= n[0] && n[0] <= 'Z'):
+		return uint16(n[0]), true
+	case len(n) >= 2 && n[0] == 'F':
+		if f, err := strconv.ParseUint(n[1:], 10, 8); err == nil && n[1] != '0' && 1 <= f && f <= 24 {
+			return uint16(VK_F1 + f - 1), true
+		}
+	case strings.HasPrefix(n, "0X"):
+		if vk, err := strconv.ParseUint(n[2:], 16, 8); err == nil && 0x01 <= vk && vk <= 0xFE {
+			return uint16(vk), true
+		}
+	}
+
+	for _, k := range vkNames {
+		if strings.EqualFold(k.name, name) {
+			return k.vk, true
+		}
+	}
+
+	return 0, false
+}
+
+// vkName returns the name of the virtual key vk, which [vkByName] reads back.
+func vkName(vk uint16) string {
+	switch {
+	case '0' <= vk && vk <= '9', 'A' <= vk && vk <= 'Z':
+		return string(rune(vk))
+	case VK_F1 <= vk && vk <= VK_F24:
+		return "F" + strconv.Itoa(int(vk-VK_F1+1))
+	}
+
+	for _, k := range vkNames {
+		if k.vk == vk {
+			return k.name
+		}
+	}
+
+	return fmt.Sprintf("0x%02X", vk)
+}
+
+// #endregion
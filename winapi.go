@@ -7,10 +7,9 @@
 // [sys.windows]: https://pkg.go.dev/golang.org/x/sys/windows
 package winapi
 
-// #region constants
+import "syscall"
 
-// VK_UNASSIGNED is the last unassigned virtual key code.
-const VK_UNASSIGNED = 0xE8
+// #region constants
 
 // SFVIDM_REFRESH is the id sent to refresh a menu/window.
 const SFVIDM_REFRESH = 41504
@@ -18,17 +17,21 @@ const SFVIDM_REFRESH = 41504
 // #endregion
 // #region factories
 
-// NewMouseInput returns an [INPUT_Mi] with the provided [MOUSEINPUT].
+// NewMouseInput returns an [INPUT_Mi] with the provided [MOUSEINPUT], stamped
+// with the [InjectionTag] if [EnableInjectionTag] is on.
 func NewMouseInput(mi MOUSEINPUT) (input INPUT_Mi) {
 	input.Type = INPUT_MOUSE
 	input.Mi = mi
+	stampExtraInfo(&input.Mi.ExtraInfo)
 	return input
 }
 
-// NewKeybdInput returns an [INPUT_Ki] with the provided [KEYBDINPUT].
+// NewKeybdInput returns an [INPUT_Ki] with the provided [KEYBDINPUT], stamped
+// with the [InjectionTag] if [EnableInjectionTag] is on.
 func NewKeybdInput(ki KEYBDINPUT) (input INPUT_Ki) {
 	input.Type = INPUT_KEYBOARD
 	input.Ki = ki
+	stampExtraInfo(&input.Ki.ExtraInfo)
 	return input
 }
 
@@ -53,4 +56,25 @@ func toBOOL(b bool) int32 {
 	return 0
 }
 
+// optUTF16Ptr converts s to a NUL-terminated UTF-16 string.
+// It returns nil if s is empty, for parameters where NULL has a meaning of its
+// own.
+func optUTF16Ptr(s string) (*uint16, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	return syscall.UTF16PtrFromString(s)
+}
+
+// hresult converts an HRESULT into an error.
+// It returns nil if hr indicates success (including S_FALSE).
+func hresult(hr uintptr) error {
+	if int32(hr) < 0 {
+		return syscall.Errno(hr)
+	}
+
+	return nil
+}
+
 // #endregion
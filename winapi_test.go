@@ -11,14 +11,6 @@ import (
 	"github.com/kamaranl/winapi"
 )
 
-var enabled = map[string]bool{
-	"NewMouseInput":    true,
-	"NewKeybdInput":    true,
-	"NewHardwareInput": true,
-	"SendInputMi":      true,
-	"SendInputKi":      true,
-}
-
 func TestNewMouseInput(t *testing.T) {
 	tName := "NewMouseInput"
 	if !enabled[tName] {
@@ -127,3 +119,34 @@ func TestNewHardwareInput(t *testing.T) {
 		})
 	}
 }
+
+func TestInjectionTag(t *testing.T) {
+	tName := "InjectionTag"
+	if !enabled[tName] {
+		t.Skip(tName + test.TestsDisabled)
+	}
+
+	winapi.EnableInjectionTag(true)
+	defer winapi.EnableInjectionTag(false)
+
+	scenes := []test.Scene{
+		{Input: uintptr(0), Output: winapi.InjectionTag()},
+		{Input: uintptr(42), Output: uintptr(42)},
+	}
+
+	for i, s := range scenes {
+		t.Run(fmt.Sprintf(tName+" #%d", i), func(t *testing.T) {
+			want := s.Output.(uintptr)
+
+			ki := winapi.NewKeybdInput(winapi.KEYBDINPUT{ExtraInfo: s.Input.(uintptr)})
+			if ki.Ki.ExtraInfo != want {
+				t.Errorf(test.ErrWantFGotF, want, ki.Ki.ExtraInfo)
+			}
+
+			mi := winapi.NewMouseInput(winapi.MOUSEINPUT{ExtraInfo: s.Input.(uintptr)})
+			if mi.Mi.ExtraInfo != want {
+				t.Errorf(test.ErrWantFGotF, want, mi.Mi.ExtraInfo)
+			}
+		})
+	}
+}